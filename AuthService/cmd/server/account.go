@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"unicode/utf8"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/database"
+	"AuthService/internal/model"
+	"AuthService/internal/policy"
+
+	pb "Proto"
+)
+
+func (s *server) Register(ctx context.Context, user_request *pb.RegisterMsg) (*pb.RegisterReply, error) {
+	if user_request.Email == "" && user_request.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "email or username is required")
+	}
+
+	var email, username *string
+	identifiers := []string{}
+	if user_request.Email != "" {
+		normalized, err := auth.NormalizeEmail(user_request.Email)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		email = &normalized
+		identifiers = append(identifiers, normalized)
+	}
+	if user_request.Username != "" {
+		normalized, err := auth.NormalizeUsername(user_request.Username)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		username = &normalized
+		identifiers = append(identifiers, normalized)
+	}
+
+	if violations := s.PasswordPolicy.Check(user_request.Password, identifiers...); len(violations) != 0 {
+		return nil, passwordPolicyError(violations)
+	}
+
+	password_hash, err := s.PasswordHasher.HashPassword(user_request.Password)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+
+	guid, err := s.MainDB.RegisterUser(email, username, password_hash)
+	if err != nil {
+		if errors.Is(err, database.ErrUserExists) {
+			return nil, status.Error(codes.AlreadyExists, "user with such email or username already exists")
+		}
+		return nil, status.Error(codes.Internal, "failed to add user to database")
+	}
+
+	log.Infof("user %v registered", guid)
+	go s.sendVerificationEmail(context.Background(), &model.User{GUID: guid, Email: email})
+	return &pb.RegisterReply{Guid: guid}, nil
+}
+
+// Login checks password of user found by email or username and issues token pair like GetTokens.
+// Unknown user and wrong password give the same error after the same amount of hashing work.
+// With TOTP enabled only mfa_challenge is returned, tokens are issued by VerifyMFA.
+func (s *server) Login(ctx context.Context, user_request *pb.LoginMsg) (*pb.GetTokensReply, error) {
+	if auth.HasScope(user_request.Scope, auth.OpenIDScope) && user_request.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_id is required for openid scope")
+	}
+
+	user_agent, user_ip, err := clientInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid_credentials := status.Error(codes.Unauthenticated, "invalid credentials")
+	if len(user_request.Password) > s.PasswordPolicy.MaxLength*utf8.UTFMax {
+		return nil, invalid_credentials
+	}
+
+	password_hash := s.dummy_hash
+	var user *model.User
+	if identifier, err := auth.NormalizeIdentifier(user_request.Identifier); err == nil {
+		user, err = s.MainDB.SearchUserByIdentifier(identifier)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Internal, "failed to search user")
+		}
+	}
+	if user != nil && user.PasswordHash != "" {
+		password_hash = user.PasswordHash
+	}
+
+	is_valid, err := s.PasswordHasher.VerifyPassword(user_request.Password, password_hash)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to verify password")
+	}
+	if !is_valid || password_hash == s.dummy_hash {
+		log.Infof("failed login attempt from %v", user_ip)
+		return nil, invalid_credentials
+	}
+
+	if s.PasswordHasher.NeedsRehash(password_hash) {
+		if new_hash, err := s.PasswordHasher.HashPassword(user_request.Password); err == nil {
+			if err := s.MainDB.UpdatePasswordHash(user.GUID, new_hash); err != nil {
+				log.Errorf("failed to rehash password of %v: %v", user.GUID, err)
+			}
+		}
+	}
+
+	challenge, err := s.mfaChallenge(user)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != "" {
+		return &pb.GetTokensReply{MfaChallenge: challenge}, nil
+	}
+
+	return s.issueTokens(ctx, user, user_request.Scope, user_request.ClientId, user_request.Nonce, user_agent, user_ip)
+}
+
+// passwordPolicyError lists every failed rule in BadRequest details, so client can show them all at once
+func passwordPolicyError(violations []policy.Violation) error {
+	field_violations := []*errdetails.BadRequest_FieldViolation{}
+	for _, violation := range violations {
+		field_violations = append(field_violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "password",
+			Reason:      violation.Reason,
+			Description: violation.Description,
+		})
+	}
+
+	policy_status := status.New(codes.InvalidArgument, "password does not satisfy policy")
+	with_details, err := policy_status.WithDetails(&errdetails.BadRequest{FieldViolations: field_violations})
+	if err != nil {
+		log.Errorf("failed to add error details: %v", err)
+		return policy_status.Err()
+	}
+
+	return with_details.Err()
+}
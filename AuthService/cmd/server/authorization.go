@@ -0,0 +1,444 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/database"
+	"AuthService/internal/model"
+	"AuthService/source/utils"
+
+	pb "Proto"
+)
+
+// oauth_config LoginURL is page which logs user in and calls ApproveAuthorization
+type oauth_config struct {
+	CodeTTL  time.Duration
+	LoginURL string
+}
+
+// Authorize is OAuth 2.1 authorization endpoint. Request is checked and browser is redirected to login page
+// with the same parameters. Unknown client or redirect_uri are reported to browser, because redirecting
+// there would make the service an open redirector, other errors are sent to client's redirect_uri.
+func (s *server) Authorize(ctx context.Context, user_request *pb.AuthorizeMsg) (*emptypb.Empty, error) {
+	setCacheControl(ctx, "no-store")
+
+	_, oauth_err, err := s.checkAuthorizationRequest(user_request)
+	if err != nil {
+		return nil, err
+	}
+
+	location := s.OAuth.LoginURL + "?" + authorizationQuery(user_request).Encode()
+	if oauth_err != nil {
+		location, err = authorizationError(user_request, oauth_err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	setHTTPCode(ctx, http.StatusFound, "location", location)
+	return &emptypb.Empty{}, nil
+}
+
+// ApproveAuthorization is called by login page with access token of logged in user. It issues single-use
+// authorization code and returns redirect to client, the page navigates there itself.
+// Codes issued to the user before keep working, so several clients can be authorized at once.
+func (s *server) ApproveAuthorization(ctx context.Context, user_request *pb.AuthorizeMsg) (*pb.ApproveAuthorizationReply, error) {
+	claims, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	//token of oauth client must not act for the user on login page
+	if claims.ClientID != "" {
+		return nil, status.Error(codes.PermissionDenied, "only first-party token can approve authorization")
+	}
+	if _, err := s.MainDB.SearchSession(claims.GUID, claims.SessionId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "session is closed, login again")
+		}
+		return nil, status.Error(codes.Internal, "failed to search session")
+	}
+
+	authorization_request, oauth_err, err := s.checkAuthorizationRequest(user_request)
+	if err != nil {
+		return nil, err
+	}
+	if oauth_err != nil {
+		redirect_to, err := authorizationError(user_request, oauth_err)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.ApproveAuthorizationReply{RedirectTo: redirect_to}, nil
+	}
+
+	data, err := json.Marshal(authorization_request)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to marshal authorization request")
+	}
+	action_token, code, err := s.ActionTokens.GenerateActionToken(auth.OAuthCodePurpose, claims.GUID, s.OAuth.CodeTTL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate authorization code")
+	}
+	action_token.Data = string(data)
+	if err := s.MainDB.AddActionToken(action_token); err != nil {
+		return nil, status.Error(codes.Internal, "failed to save authorization code")
+	}
+
+	redirect_to, err := auth.AuthorizationRedirect(user_request.RedirectUri, map[string]string{"code": code, "state": user_request.State})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to build redirect")
+	}
+	log.Infof("authorization code issued to %v for client %v", claims.GUID, user_request.ClientId)
+
+	return &pb.ApproveAuthorizationReply{RedirectTo: redirect_to}, nil
+}
+
+// checkAuthorizationRequest returns error when client or redirect_uri are wrong and OAuthError
+// for everything else, it has to be delivered to redirect_uri
+func (s *server) checkAuthorizationRequest(user_request *pb.AuthorizeMsg) (*auth.AuthorizationRequest, *auth.OAuthError, error) {
+	if user_request.ClientId == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "client_id is required")
+	}
+	client, err := s.searchClient(user_request.ClientId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !auth.HasRedirectURI(client, user_request.RedirectUri) {
+		return nil, nil, status.Error(codes.InvalidArgument, "redirect_uri is not registered for client")
+	}
+
+	if user_request.ResponseType != auth.CodeResponseType {
+		return nil, &auth.OAuthError{Code: auth.UnsupportedResponseTypeError, Description: "only code response type is supported"}, nil
+	}
+	if !auth.HasGrantType(client, auth.AuthorizationCodeGrant) {
+		return nil, &auth.OAuthError{Code: auth.UnauthorizedClientError, Description: "client is not allowed to use authorization code"}, nil
+	}
+	if !auth.AllowsScope(client, user_request.Scope) {
+		return nil, &auth.OAuthError{Code: auth.InvalidScopeError, Description: "scope is not allowed for client"}, nil
+	}
+	if user_request.CodeChallengeMethod != auth.S256ChallengeMethod || !auth.IsValidPKCEValue(user_request.CodeChallenge) {
+		return nil, &auth.OAuthError{Code: auth.InvalidRequestError, Description: "code_challenge with S256 method is required"}, nil
+	}
+
+	return &auth.AuthorizationRequest{
+		ClientID:      client.ID,
+		RedirectURI:   user_request.RedirectUri,
+		CodeChallenge: user_request.CodeChallenge,
+		Scope:         user_request.Scope,
+		Nonce:         user_request.Nonce,
+	}, nil, nil
+}
+
+func authorizationQuery(user_request *pb.AuthorizeMsg) url.Values {
+	query := url.Values{}
+	params := map[string]string{
+		"response_type":         user_request.ResponseType,
+		"client_id":             user_request.ClientId,
+		"redirect_uri":          user_request.RedirectUri,
+		"code_challenge":        user_request.CodeChallenge,
+		"code_challenge_method": user_request.CodeChallengeMethod,
+		"state":                 user_request.State,
+		"scope":                 user_request.Scope,
+		"nonce":                 user_request.Nonce,
+	}
+	for key, value := range params {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+
+	return query
+}
+
+func authorizationError(user_request *pb.AuthorizeMsg, oauth_err *auth.OAuthError) (string, error) {
+	location, err := auth.AuthorizationRedirect(user_request.RedirectUri, map[string]string{
+		"error":             oauth_err.Code,
+		"error_description": oauth_err.Description,
+		"state":             user_request.State,
+	})
+	if err != nil {
+		return "", status.Error(codes.Internal, "failed to build redirect")
+	}
+
+	return location, nil
+}
+
+// Token is OAuth 2.1 token endpoint, it supports authorization_code and refresh_token grants. Refresh token is
+// returned only to clients allowed to refresh. Confidential clients authenticate with client_secret_basic or
+// client_secret_post. Errors are answered with OAuth json, not with grpc status.
+func (s *server) Token(ctx context.Context, user_request *pb.TokenMsg) (*httpbody.HttpBody, error) {
+	setCacheControl(ctx, "no-store")
+
+	response, err := s.grantTokens(ctx, user_request)
+	if err != nil {
+		return oauthErrorBody(ctx, err)
+	}
+
+	body, err := jsonBody(response)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to marshal token response")
+	}
+	return body, nil
+}
+
+// grantTokens authenticates client and passes request to handler of its grant type
+func (s *server) grantTokens(ctx context.Context, user_request *pb.TokenMsg) (*auth.TokenResponse, error) {
+	if user_request.GrantType != auth.AuthorizationCodeGrant && user_request.GrantType != auth.RefreshTokenGrant {
+		return nil, &auth.OAuthError{Code: auth.UnsupportedGrantTypeError, Description: "only authorization_code and refresh_token grants are supported"}
+	}
+
+	user_agent, user_ip, err := clientInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.authenticateClient(ctx, user_request.ClientId, user_request.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if !auth.HasGrantType(client, user_request.GrantType) {
+		return nil, &auth.OAuthError{Code: auth.UnauthorizedClientError, Description: "client is not allowed to use " + user_request.GrantType + " grant"}
+	}
+
+	if user_request.GrantType == auth.RefreshTokenGrant {
+		return s.refreshClientTokens(ctx, user_request, client, user_agent, user_ip)
+	}
+	return s.exchangeCode(ctx, user_request, client, user_agent, user_ip)
+}
+
+// exchangeCode uses authorization code before checking PKCE and redirect_uri, so every code can be tried only once
+func (s *server) exchangeCode(ctx context.Context, user_request *pb.TokenMsg, client *model.OAuthClient, user_agent string, user_ip string) (*auth.TokenResponse, error) {
+
+	invalid_code := &auth.OAuthError{Code: auth.InvalidGrantError, Description: "authorization code is invalid or expired"}
+	selector, _, err := auth.SplitActionToken(user_request.Code)
+	if err != nil {
+		return nil, invalid_code
+	}
+	action_token, err := s.MainDB.SearchActionToken(auth.OAuthCodePurpose, selector)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid_code
+		}
+		return nil, status.Error(codes.Internal, "failed to search authorization code")
+	}
+	if !s.ActionTokens.VerifyActionToken(user_request.Code, action_token) {
+		return nil, invalid_code
+	}
+	if err := s.MainDB.UseActionToken(action_token); err != nil {
+		if errors.Is(err, database.ErrActionTokenUsed) {
+			return nil, invalid_code
+		}
+		return nil, status.Error(codes.Internal, "failed to use authorization code")
+	}
+
+	authorization_request := auth.AuthorizationRequest{}
+	if err := json.Unmarshal([]byte(action_token.Data), &authorization_request); err != nil {
+		log.Errorf("failed to unmarshal authorization request: %v", err)
+		return nil, status.Error(codes.Internal, "failed to read authorization code")
+	}
+	if authorization_request.ClientID != client.ID || authorization_request.RedirectURI != user_request.RedirectUri {
+		return nil, &auth.OAuthError{Code: auth.InvalidGrantError, Description: "client_id or redirect_uri doesn't match authorization request"}
+	}
+	if !auth.VerifyPKCE(authorization_request.CodeChallenge, user_request.CodeVerifier) {
+		log.Infof("wrong code_verifier for client %v from %v", client.ID, user_ip)
+		return nil, &auth.OAuthError{Code: auth.InvalidGrantError, Description: "code_verifier doesn't match code_challenge"}
+	}
+
+	user, err := s.MainDB.GetUser(action_token.UserGUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to search user")
+	}
+
+	reply, err := s.issueClientTokens(ctx, user, authorization_request.Scope, client, authorization_request.Nonce, user_agent, user_ip)
+	if err != nil {
+		return nil, invalidGrant(err)
+	}
+
+	response := &auth.TokenResponse{
+		AccessToken: reply.Access,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(s.accessLifeTime(client).Seconds()),
+		IDToken:     reply.IdToken,
+		Scope:       authorization_request.Scope,
+	}
+	if auth.HasGrantType(client, auth.RefreshTokenGrant) {
+		response.RefreshToken = reply.Refresh
+	}
+
+	return response, nil
+}
+
+// refreshClientTokens rotates session of client like RefreshTokens does for first-party sessions. Refresh token
+// is accepted only from the client it was issued to, access tokens of the old session are revoked.
+func (s *server) refreshClientTokens(ctx context.Context, user_request *pb.TokenMsg, client *model.OAuthClient, user_agent string, user_ip string) (*auth.TokenResponse, error) {
+	invalid_refresh := &auth.OAuthError{Code: auth.InvalidGrantError, Description: "refresh token is invalid or expired"}
+
+	if err := s.detectRefreshReuse(ctx, user_request.RefreshToken, user_ip, user_agent); err != nil {
+		return nil, invalidGrant(err)
+	}
+
+	session, err := s.MainDB.SearchSessionByRefresh(user_request.RefreshToken)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid_refresh
+		}
+		return nil, status.Error(codes.Internal, "failed to search session")
+	}
+	if !s.RefreshManager.VerifyRefresh(user_request.RefreshToken, session) {
+		return nil, invalid_refresh
+	}
+	if session.ClientID != client.ID {
+		log.Infof("client %v presented refresh token of session %v", client.ID, session.ID)
+		return nil, invalid_refresh
+	}
+
+	if session.UserAgent != user_agent {
+		//access tokens of the session may be stolen together with refresh token
+		if err := s.revokeSession(ctx, session); err != nil {
+			return nil, err
+		}
+		return nil, &auth.OAuthError{Code: auth.InvalidGrantError, Description: "user-agent changed, session deauthorized"}
+	}
+	if err := s.RefreshManager.CheckExpiry(session); err != nil {
+		if err := s.MainDB.DeleteSession(session.UserGUID, session.ID); err != nil {
+			return nil, status.Error(codes.Internal, "failed to delete session")
+		}
+		return nil, &auth.OAuthError{Code: auth.InvalidGrantError, Description: err.Error()}
+	}
+
+	user, err := s.MainDB.GetUser(session.UserGUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to search user")
+	}
+	if err := s.checkEmailVerified(user); err != nil {
+		return nil, invalidGrant(err)
+	}
+
+	session_id, new_refresh, err := s.MainDB.RotateSession(session, user_request.RefreshToken, s.RefreshManager, s.refreshLifeTime(client), user_agent, user_ip)
+	if err != nil {
+		//concurrent refresh with the same token already rotated the session, family is not revoked like in RefreshTokens
+		if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, database.ErrRefreshMismatch) {
+			return nil, invalid_refresh
+		}
+		return nil, status.Error(codes.Internal, "failed to rotate session")
+	}
+
+	//client doesn't present access token here, so all tokens of the old session are revoked
+	access_expiry, err := s.accessExpiry()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.BlacklistManager.RevokeSession(ctx, session.ID, access_expiry); err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke session")
+	}
+
+	access, err := s.AuthManager.GenerateToken(user, session_id, client.ID, session.Scope, s.accessLifeTime(client))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate access token")
+	}
+
+	return &auth.TokenResponse{
+		AccessToken:  access,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(s.accessLifeTime(client).Seconds()),
+		RefreshToken: new_refresh,
+		Scope:        session.Scope,
+	}, nil
+}
+
+// invalidGrant turns grpc error into invalid_grant: when user can't get tokens right now (unverified email,
+// session limit, revoked family) the grant is invalid for client. Internal errors are kept.
+func invalidGrant(err error) error {
+	if status.Code(err) == codes.Internal {
+		return err
+	}
+
+	return &auth.OAuthError{Code: auth.InvalidGrantError, Description: status.Convert(err).Message()}
+}
+
+// authenticateClient finds client of oauth request. Secret is taken from Basic authorization header or from
+// client_secret parameter, public clients must not send it.
+func (s *server) authenticateClient(ctx context.Context, client_id string, client_secret string) (*model.OAuthClient, error) {
+	invalid_client := &auth.OAuthError{Code: auth.InvalidClientError, Description: "client authentication failed"}
+
+	if authorization, err := utils.GetFromMetadata(ctx, "authorization"); err == nil {
+		basic_id, basic_secret, ok := auth.ParseBasicAuth(authorization)
+		if !ok || client_secret != "" || (client_id != "" && client_id != basic_id) {
+			return nil, invalid_client
+		}
+		client_id, client_secret = basic_id, basic_secret
+	}
+
+	client, err := s.MainDB.GetClient(client_id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid_client
+		}
+		return nil, status.Error(codes.Internal, "failed to search client")
+	}
+	if client.Disabled {
+		return nil, invalid_client
+	}
+
+	if auth.IsConfidential(client) != (client_secret != "") {
+		return nil, invalid_client
+	}
+	if auth.IsConfidential(client) && !s.ClientSecrets.VerifySecret(client_secret, client) {
+		log.Infof("wrong secret of client %v", client.ID)
+		return nil, invalid_client
+	}
+
+	return client, nil
+}
+
+// oauthErrorBody answers OAuthError with its json and http status, other errors are returned as they are
+func oauthErrorBody(ctx context.Context, err error) (*httpbody.HttpBody, error) {
+	var oauth_err *auth.OAuthError
+	if !errors.As(err, &oauth_err) {
+		return nil, err
+	}
+
+	http_code := http.StatusBadRequest
+	if oauth_err.Code == auth.InvalidClientError {
+		http_code = http.StatusUnauthorized
+	}
+	setHTTPCode(ctx, http_code)
+
+	body, err := jsonBody(oauth_err)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to marshal oauth error")
+	}
+	return body, nil
+}
+
+// setHTTPCode asks gateway to answer with given http status, extra pairs are sent as headers
+func setHTTPCode(ctx context.Context, http_code int, headers ...string) {
+	pairs := append([]string{"x-http-code", strconv.Itoa(http_code)}, headers...)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(pairs...)); err != nil {
+		log.Errorf("failed to set http code header: %v", err)
+	}
+}
+
+func newOAuthConfig() *oauth_config {
+	code_ttl, err := strconv.Atoi(utils.GetKeyFromEnv("OAUTH_CODE_TTL"))
+	if err != nil || code_ttl <= 0 {
+		log.Fatalf("invalid OAUTH_CODE_TTL: %v", utils.GetKeyFromEnv("OAUTH_CODE_TTL"))
+	}
+
+	return &oauth_config{CodeTTL: time.Duration(code_ttl) * time.Second, LoginURL: utils.GetKeyFromEnv("OAUTH_LOGIN_URL")}
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/model"
+
+	pb "Proto"
+)
+
+// verifier and challenge of RFC 7636 appendix B
+const (
+	testVerifier  = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	testChallenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+)
+
+// testClient registers client, secret is empty for public client
+func testClient(t *testing.T, s *server, db *fakeDB, client_id string, confidential bool) string {
+	t.Helper()
+	client := &model.OAuthClient{
+		ID:           client_id,
+		GrantTypes:   auth.AuthorizationCodeGrant + " " + auth.RefreshTokenGrant,
+		RedirectURIs: "https://" + client_id + ".example.com/callback",
+	}
+	secret := ""
+	if confidential {
+		var err error
+		secret, client.SecretHash, err = s.ClientSecrets.GenerateSecret()
+		if err != nil {
+			t.Fatalf("GenerateSecret() = %v", err)
+		}
+	}
+	db.clients[client_id] = client
+	return secret
+}
+
+// authorizationCode approves authorization request of client for logged in user
+func authorizationCode(t *testing.T, s *server, db *fakeDB, client_id string) string {
+	t.Helper()
+	_, access, _ := login(t, s, db, "user-guid", "agent")
+	reply, err := s.ApproveAuthorization(requestContext(access, "agent"), &pb.AuthorizeMsg{
+		ResponseType:        auth.CodeResponseType,
+		ClientId:            client_id,
+		RedirectUri:         "https://" + client_id + ".example.com/callback",
+		CodeChallenge:       testChallenge,
+		CodeChallengeMethod: auth.S256ChallengeMethod,
+		State:               "state",
+	})
+	if err != nil {
+		t.Fatalf("ApproveAuthorization() = %v", err)
+	}
+	redirect, err := url.Parse(reply.RedirectTo)
+	if err != nil || redirect.Query().Get("code") == "" {
+		t.Fatalf("ApproveAuthorization() redirects to %v", reply.RedirectTo)
+	}
+	return redirect.Query().Get("code")
+}
+
+// basicContext is request of client authenticated with Basic authorization header
+func basicContext(user_agent string, client_id string, client_secret string) context.Context {
+	credentials := url.QueryEscape(client_id) + ":" + url.QueryEscape(client_secret)
+	md := metadata.Pairs("x-user-agent", user_agent, "x-forwarded-for", "127.0.0.1", "authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(credentials)))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func assertOAuthError(t *testing.T, err error, code string) {
+	t.Helper()
+	var oauth_err *auth.OAuthError
+	if !errors.As(err, &oauth_err) || oauth_err.Code != code {
+		t.Fatalf("error = %v, want %v", err, code)
+	}
+}
+
+func TestExchangeCode(t *testing.T) {
+	tests := []struct {
+		name          string
+		client_id     string
+		redirect_uri  string
+		code_verifier string
+		error         string
+	}{
+		{"valid", "web-app", "https://web-app.example.com/callback", testVerifier, ""},
+		{"wrong code_verifier", "web-app", "https://web-app.example.com/callback", testVerifier[:42] + "a", auth.InvalidGrantError},
+		{"no code_verifier", "web-app", "https://web-app.example.com/callback", "", auth.InvalidGrantError},
+		{"other redirect_uri", "web-app", "https://web-app.example.com/other", testVerifier, auth.InvalidGrantError},
+		{"other client", "mobile-app", "https://mobile-app.example.com/callback", testVerifier, auth.InvalidGrantError},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, db, _ := testServer(t)
+			testClient(t, s, db, "web-app", false)
+			testClient(t, s, db, "mobile-app", false)
+			code := authorizationCode(t, s, db, "web-app")
+
+			response, err := s.grantTokens(requestContext("", "agent"), &pb.TokenMsg{
+				GrantType:    auth.AuthorizationCodeGrant,
+				Code:         code,
+				ClientId:     test.client_id,
+				RedirectUri:  test.redirect_uri,
+				CodeVerifier: test.code_verifier,
+			})
+			if test.error != "" {
+				assertOAuthError(t, err, test.error)
+			} else if err != nil || response.AccessToken == "" || response.RefreshToken == "" {
+				t.Fatalf("grantTokens() = %v, %v", response, err)
+			}
+
+			//code is used even by failed request, so it can't be guessed
+			_, err = s.grantTokens(requestContext("", "agent"), &pb.TokenMsg{
+				GrantType:    auth.AuthorizationCodeGrant,
+				Code:         code,
+				ClientId:     "web-app",
+				RedirectUri:  "https://web-app.example.com/callback",
+				CodeVerifier: testVerifier,
+			})
+			assertOAuthError(t, err, auth.InvalidGrantError)
+		})
+	}
+}
+
+func TestApproveAuthorizationKeepsCodes(t *testing.T) {
+	s, db, _ := testServer(t)
+	testClient(t, s, db, "web-app", false)
+	testClient(t, s, db, "mobile-app", false)
+
+	//user authorizes two clients at once, the second code doesn't break the first one
+	web_code := authorizationCode(t, s, db, "web-app")
+	mobile_code := authorizationCode(t, s, db, "mobile-app")
+	for client_id, code := range map[string]string{"web-app": web_code, "mobile-app": mobile_code} {
+		_, err := s.grantTokens(requestContext("", "agent"), &pb.TokenMsg{
+			GrantType:    auth.AuthorizationCodeGrant,
+			Code:         code,
+			ClientId:     client_id,
+			RedirectUri:  "https://" + client_id + ".example.com/callback",
+			CodeVerifier: testVerifier,
+		})
+		if err != nil {
+			t.Errorf("grantTokens() for %v = %v", client_id, err)
+		}
+	}
+}
+
+func TestTokenClientAuthentication(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           func(secret string) context.Context
+		client_id     string
+		client_secret func(secret string) string
+		error         string
+	}{
+		{"client_secret_post", func(secret string) context.Context { return requestContext("", "agent") }, "web-app", func(secret string) string { return secret }, ""},
+		{"client_secret_basic", func(secret string) context.Context { return basicContext("agent", "web-app", secret) }, "", func(secret string) string { return "" }, ""},
+		{"basic with the same client_id", func(secret string) context.Context { return basicContext("agent", "web-app", secret) }, "web-app", func(secret string) string { return "" }, ""},
+		{"basic with other client_id", func(secret string) context.Context { return basicContext("agent", "web-app", secret) }, "mobile-app", func(secret string) string { return "" }, auth.InvalidClientError},
+		{"basic and post secret", func(secret string) context.Context { return basicContext("agent", "web-app", secret) }, "", func(secret string) string { return secret }, auth.InvalidClientError},
+		{"wrong basic secret", func(secret string) context.Context { return basicContext("agent", "web-app", "wrong") }, "", func(secret string) string { return "" }, auth.InvalidClientError},
+		{"wrong post secret", func(secret string) context.Context { return requestContext("", "agent") }, "web-app", func(secret string) string { return "wrong" }, auth.InvalidClientError},
+		{"no secret", func(secret string) context.Context { return requestContext("", "agent") }, "web-app", func(secret string) string { return "" }, auth.InvalidClientError},
+		{"unknown client", func(secret string) context.Context { return basicContext("agent", "unknown-app", secret) }, "", func(secret string) string { return "" }, auth.InvalidClientError},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, db, _ := testServer(t)
+			secret := testClient(t, s, db, "web-app", true)
+			code := authorizationCode(t, s, db, "web-app")
+
+			response, err := s.grantTokens(test.ctx(secret), &pb.TokenMsg{
+				GrantType:    auth.AuthorizationCodeGrant,
+				Code:         code,
+				ClientId:     test.client_id,
+				ClientSecret: test.client_secret(secret),
+				RedirectUri:  "https://web-app.example.com/callback",
+				CodeVerifier: testVerifier,
+			})
+			if test.error != "" {
+				assertOAuthError(t, err, test.error)
+			} else if err != nil || response.AccessToken == "" {
+				t.Fatalf("grantTokens() = %v, %v", response, err)
+			}
+		})
+	}
+
+	//public client must not send secret
+	s, db, _ := testServer(t)
+	testClient(t, s, db, "mobile-app", false)
+	_, err := s.grantTokens(basicContext("agent", "mobile-app", "secret"), &pb.TokenMsg{GrantType: auth.RefreshTokenGrant, RefreshToken: "refresh"})
+	assertOAuthError(t, err, auth.InvalidClientError)
+}
+
+func TestRefreshClientTokensUserAgentChanged(t *testing.T) {
+	s, db, blacklist := testServer(t)
+	secret := testClient(t, s, db, "web-app", true)
+	code := authorizationCode(t, s, db, "web-app")
+	response, err := s.grantTokens(basicContext("agent", "web-app", secret), &pb.TokenMsg{
+		GrantType:    auth.AuthorizationCodeGrant,
+		Code:         code,
+		RedirectUri:  "https://web-app.example.com/callback",
+		CodeVerifier: testVerifier,
+	})
+	if err != nil {
+		t.Fatalf("grantTokens() = %v", err)
+	}
+	claims, err := s.AuthManager.VerifyToken("Bearer "+response.AccessToken, true)
+	if err != nil {
+		t.Fatalf("VerifyToken() = %v", err)
+	}
+
+	_, err = s.grantTokens(basicContext("other agent", "web-app", secret), &pb.TokenMsg{GrantType: auth.RefreshTokenGrant, RefreshToken: response.RefreshToken})
+	assertOAuthError(t, err, auth.InvalidGrantError)
+
+	//session is closed together with its access tokens
+	if _, err := db.SearchSession("user-guid", claims.SessionId); err == nil {
+		t.Errorf("session %v is not deleted", claims.SessionId)
+	}
+	if !blacklist.sessions[claims.SessionId] {
+		t.Errorf("access tokens of session %v are not revoked", claims.SessionId)
+	}
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/database"
+	"AuthService/internal/model"
+	"AuthService/source/utils"
+
+	pb "Proto"
+)
+
+// CreateClient registers client. Secret of confidential client is returned only here and by RotateClientSecret.
+func (s *server) CreateClient(ctx context.Context, user_request *pb.CreateClientMsg) (*pb.ClientReply, error) {
+	if err := s.authenticateAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := newClient(user_request.ClientId, user_request.GrantTypes, user_request.RedirectUris, user_request.Scopes, user_request.AccessLifeTime, user_request.RefreshLifeTime)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := ""
+	if user_request.Confidential {
+		secret, client.SecretHash, err = s.ClientSecrets.GenerateSecret()
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to generate client secret")
+		}
+	}
+
+	if err := s.MainDB.AddClient(client); err != nil {
+		if errors.Is(err, database.ErrClientExists) {
+			return nil, status.Error(codes.AlreadyExists, "client already exists")
+		}
+		return nil, status.Error(codes.Internal, "failed to add client")
+	}
+	log.Infof("oauth client %v created", client.ID)
+
+	return clientReply(client, secret), nil
+}
+
+// UpdateClient replaces settings of client. New lifetimes apply to tokens and sessions created after update.
+func (s *server) UpdateClient(ctx context.Context, user_request *pb.UpdateClientMsg) (*pb.ClientReply, error) {
+	if err := s.authenticateAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := newClient(user_request.ClientId, user_request.GrantTypes, user_request.RedirectUris, user_request.Scopes, user_request.AccessLifeTime, user_request.RefreshLifeTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.MainDB.UpdateClient(client); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "client not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to update client")
+	}
+	log.Infof("oauth client %v updated", client.ID)
+
+	return s.getClientReply(client.ID, "")
+}
+
+// RotateClientSecret replaces secret of confidential client, the old one stops working at once
+func (s *server) RotateClientSecret(ctx context.Context, user_request *pb.RotateClientSecretMsg) (*pb.ClientReply, error) {
+	if err := s.authenticateAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := s.MainDB.GetClient(user_request.ClientId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "client not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to search client")
+	}
+	if !auth.IsConfidential(client) {
+		return nil, status.Error(codes.FailedPrecondition, "public client has no secret")
+	}
+
+	secret, secret_hash, err := s.ClientSecrets.GenerateSecret()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate client secret")
+	}
+	if err := s.MainDB.UpdateClientSecret(client.ID, secret_hash); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update client secret")
+	}
+	log.Infof("secret of oauth client %v rotated", client.ID)
+
+	return s.getClientReply(client.ID, secret)
+}
+
+// DisableClient stops authorization and refresh for client. Already issued access tokens live until they expire.
+func (s *server) DisableClient(ctx context.Context, user_request *pb.DisableClientMsg) (*emptypb.Empty, error) {
+	if err := s.authenticateAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.MainDB.DisableClient(user_request.ClientId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "client not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to disable client")
+	}
+	log.Infof("oauth client %v disabled", user_request.ClientId)
+
+	return &emptypb.Empty{}, nil
+}
+
+// authenticateAdmin checks x-admin-key header, admin api is off when ADMIN_KEY is empty
+func (s *server) authenticateAdmin(ctx context.Context) error {
+	if s.AdminKey == "" {
+		return status.Error(codes.PermissionDenied, "admin api is disabled")
+	}
+
+	admin_key, err := utils.GetFromMetadata(ctx, "x-admin-key")
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "admin key is not provided")
+	}
+	if subtle.ConstantTimeCompare([]byte(admin_key), []byte(s.AdminKey)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid admin key")
+	}
+
+	return nil
+}
+
+func newClient(client_id string, grant_types []string, redirect_uris []string, scopes []string, access_life_time int64, refresh_life_time int64) (*model.OAuthClient, error) {
+	if client_id == "" || len(client_id) > 128 || strings.ContainsAny(client_id, " \t\r\n") {
+		return nil, status.Error(codes.InvalidArgument, "client_id must be non-empty string without spaces")
+	}
+
+	if len(grant_types) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one grant type is required")
+	}
+	for _, grant_type := range grant_types {
+		if !slices.Contains(auth.SupportedGrantTypes, grant_type) {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported grant type %v", grant_type)
+		}
+	}
+
+	if slices.Contains(grant_types, auth.AuthorizationCodeGrant) && len(redirect_uris) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "authorization_code grant requires redirect uri")
+	}
+	for _, redirect_uri := range redirect_uris {
+		parsed, err := url.Parse(redirect_uri)
+		if err != nil || !parsed.IsAbs() || parsed.Fragment != "" || strings.ContainsAny(redirect_uri, " \t\r\n") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid redirect uri %v", redirect_uri)
+		}
+	}
+
+	for _, scope := range scopes {
+		if scope == "" || strings.ContainsAny(scope, " \t\r\n") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid scope %q", scope)
+		}
+	}
+
+	if access_life_time < 0 || refresh_life_time < 0 {
+		return nil, status.Error(codes.InvalidArgument, "life time can't be negative")
+	}
+
+	return &model.OAuthClient{
+		ID:              client_id,
+		GrantTypes:      strings.Join(slices.Compact(slices.Sorted(slices.Values(grant_types))), " "),
+		RedirectURIs:    strings.Join(redirect_uris, " "),
+		Scopes:          strings.Join(scopes, " "),
+		AccessLifeTime:  access_life_time,
+		RefreshLifeTime: refresh_life_time,
+	}, nil
+}
+
+func (s *server) getClientReply(client_id string, secret string) (*pb.ClientReply, error) {
+	client, err := s.MainDB.GetClient(client_id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to search client")
+	}
+
+	return clientReply(client, secret), nil
+}
+
+func clientReply(client *model.OAuthClient, secret string) *pb.ClientReply {
+	return &pb.ClientReply{
+		ClientId:        client.ID,
+		ClientSecret:    secret,
+		Confidential:    auth.IsConfidential(client),
+		GrantTypes:      strings.Fields(client.GrantTypes),
+		RedirectUris:    strings.Fields(client.RedirectURIs),
+		Scopes:          strings.Fields(client.Scopes),
+		AccessLifeTime:  client.AccessLifeTime,
+		RefreshLifeTime: client.RefreshLifeTime,
+		Disabled:        client.Disabled,
+		CreatedAt:       client.CreatedAt,
+	}
+}
+
+// searchClient returns nil for empty client_id, first-party logins are not bound to any client
+func (s *server) searchClient(client_id string) (*model.OAuthClient, error) {
+	if client_id == "" {
+		return nil, nil
+	}
+
+	client, err := s.MainDB.GetClient(client_id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "unknown client_id")
+		}
+		return nil, status.Error(codes.Internal, "failed to search client")
+	}
+	if client.Disabled {
+		return nil, status.Error(codes.PermissionDenied, "client is disabled")
+	}
+
+	return client, nil
+}
+
+func (s *server) accessLifeTime(client *model.OAuthClient) time.Duration {
+	if client != nil && client.AccessLifeTime > 0 {
+		return time.Duration(client.AccessLifeTime) * time.Second
+	}
+
+	return s.AuthManager.GetTokenDuration()
+}
+
+func (s *server) refreshLifeTime(client *model.OAuthClient) time.Duration {
+	if client != nil && client.RefreshLifeTime > 0 {
+		return time.Duration(client.RefreshLifeTime) * time.Second
+	}
+
+	return s.RefreshManager.GetExparationTime()
+}
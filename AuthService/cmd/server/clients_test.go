@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"AuthService/internal/auth"
+
+	pb "Proto"
+)
+
+func adminContext(admin_key string) context.Context {
+	md := metadata.Pairs("x-user-agent", "agent", "x-forwarded-for", "127.0.0.1")
+	if admin_key != "" {
+		md.Set("x-admin-key", admin_key)
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestAuthenticateAdmin(t *testing.T) {
+	tests := []struct {
+		name       string
+		server_key string
+		admin_key  string
+		code       codes.Code
+	}{
+		{"valid key", "admin-key", "admin-key", codes.OK},
+		{"wrong key", "admin-key", "other-key", codes.Unauthenticated},
+		{"no key", "admin-key", "", codes.Unauthenticated},
+		{"admin api is disabled", "", "admin-key", codes.PermissionDenied},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, _, _ := testServer(t)
+			s.AdminKey = test.server_key
+			_, err := s.CreateClient(adminContext(test.admin_key), &pb.CreateClientMsg{ClientId: "service", GrantTypes: []string{auth.RefreshTokenGrant}})
+			assertCode(t, err, test.code)
+		})
+	}
+}
+
+func TestCreateClient(t *testing.T) {
+	valid := func(change func(request *pb.CreateClientMsg)) *pb.CreateClientMsg {
+		request := &pb.CreateClientMsg{
+			ClientId:     "web-app",
+			GrantTypes:   []string{auth.RefreshTokenGrant, auth.AuthorizationCodeGrant, auth.RefreshTokenGrant},
+			RedirectUris: []string{"https://web-app.example.com/callback"},
+			Scopes:       []string{"openid", "profile"},
+		}
+		change(request)
+		return request
+	}
+
+	tests := []struct {
+		name         string
+		user_request *pb.CreateClientMsg
+		code         codes.Code
+	}{
+		{"valid", valid(func(request *pb.CreateClientMsg) {}), codes.OK},
+		{"empty client_id", valid(func(request *pb.CreateClientMsg) { request.ClientId = "" }), codes.InvalidArgument},
+		{"client_id with space", valid(func(request *pb.CreateClientMsg) { request.ClientId = "web app" }), codes.InvalidArgument},
+		{"no grant types", valid(func(request *pb.CreateClientMsg) { request.GrantTypes = nil }), codes.InvalidArgument},
+		{"unsupported grant type", valid(func(request *pb.CreateClientMsg) { request.GrantTypes = []string{"password"} }), codes.InvalidArgument},
+		{"code grant without redirect uri", valid(func(request *pb.CreateClientMsg) { request.RedirectUris = nil }), codes.InvalidArgument},
+		{"relative redirect uri", valid(func(request *pb.CreateClientMsg) { request.RedirectUris = []string{"/callback"} }), codes.InvalidArgument},
+		{"redirect uri with fragment", valid(func(request *pb.CreateClientMsg) { request.RedirectUris = []string{"https://app.example.com/#cb"} }), codes.InvalidArgument},
+		{"scope with space", valid(func(request *pb.CreateClientMsg) { request.Scopes = []string{"openid profile"} }), codes.InvalidArgument},
+		{"negative life time", valid(func(request *pb.CreateClientMsg) { request.AccessLifeTime = -1 }), codes.InvalidArgument},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, _, _ := testServer(t)
+			s.AdminKey = "admin-key"
+			_, err := s.CreateClient(adminContext("admin-key"), test.user_request)
+			assertCode(t, err, test.code)
+		})
+	}
+}
+
+func TestClientRegistry(t *testing.T) {
+	s, db, _ := testServer(t)
+	s.AdminKey = "admin-key"
+	ctx := adminContext("admin-key")
+
+	reply, err := s.CreateClient(ctx, &pb.CreateClientMsg{
+		ClientId:       "web-app",
+		Confidential:   true,
+		GrantTypes:     []string{auth.RefreshTokenGrant, auth.AuthorizationCodeGrant, auth.RefreshTokenGrant},
+		RedirectUris:   []string{"https://web-app.example.com/callback"},
+		AccessLifeTime: 300,
+	})
+	if err != nil {
+		t.Fatalf("CreateClient() = %v", err)
+	}
+	//grant types are stored sorted without duplicates, secret is returned once and kept only as hash
+	if !reply.Confidential || reply.ClientSecret == "" || len(reply.GrantTypes) != 2 || reply.GrantTypes[0] != auth.AuthorizationCodeGrant {
+		t.Fatalf("CreateClient() = %v", reply)
+	}
+	if db.clients["web-app"].SecretHash == reply.ClientSecret {
+		t.Errorf("secret is stored as is")
+	}
+	secret := reply.ClientSecret
+	if _, err := s.authenticateClient(requestContext("", "agent"), "web-app", secret); err != nil {
+		t.Errorf("authenticateClient() = %v", err)
+	}
+
+	_, err = s.CreateClient(ctx, &pb.CreateClientMsg{ClientId: "web-app", GrantTypes: []string{auth.RefreshTokenGrant}})
+	assertCode(t, err, codes.AlreadyExists)
+
+	//update replaces settings and keeps secret
+	updated, err := s.UpdateClient(ctx, &pb.UpdateClientMsg{
+		ClientId:     "web-app",
+		GrantTypes:   []string{auth.AuthorizationCodeGrant},
+		RedirectUris: []string{"https://web-app.example.com/callback", "https://web-app.example.com/other"},
+		Scopes:       []string{"openid"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateClient() = %v", err)
+	}
+	if !updated.Confidential || updated.ClientSecret != "" || len(updated.RedirectUris) != 2 || updated.AccessLifeTime != 0 || len(updated.Scopes) != 1 {
+		t.Errorf("UpdateClient() = %v", updated)
+	}
+	if _, err := s.authenticateClient(requestContext("", "agent"), "web-app", secret); err != nil {
+		t.Errorf("secret stopped working after update: %v", err)
+	}
+	_, err = s.UpdateClient(ctx, &pb.UpdateClientMsg{ClientId: "unknown-app", GrantTypes: []string{auth.RefreshTokenGrant}})
+	assertCode(t, err, codes.NotFound)
+
+	//old secret stops working at once
+	rotated, err := s.RotateClientSecret(ctx, &pb.RotateClientSecretMsg{ClientId: "web-app"})
+	if err != nil {
+		t.Fatalf("RotateClientSecret() = %v", err)
+	}
+	if rotated.ClientSecret == "" || rotated.ClientSecret == secret {
+		t.Fatalf("RotateClientSecret() = %v", rotated)
+	}
+	_, err = s.authenticateClient(requestContext("", "agent"), "web-app", secret)
+	assertOAuthError(t, err, auth.InvalidClientError)
+	if _, err := s.authenticateClient(requestContext("", "agent"), "web-app", rotated.ClientSecret); err != nil {
+		t.Errorf("rotated secret is rejected: %v", err)
+	}
+	_, err = s.RotateClientSecret(ctx, &pb.RotateClientSecretMsg{ClientId: "unknown-app"})
+	assertCode(t, err, codes.NotFound)
+
+	//disabled client can't authenticate nor be used for login
+	if _, err := s.DisableClient(ctx, &pb.DisableClientMsg{ClientId: "web-app"}); err != nil {
+		t.Fatalf("DisableClient() = %v", err)
+	}
+	_, err = s.authenticateClient(requestContext("", "agent"), "web-app", rotated.ClientSecret)
+	assertOAuthError(t, err, auth.InvalidClientError)
+	_, err = s.searchClient("web-app")
+	assertCode(t, err, codes.PermissionDenied)
+	_, err = s.DisableClient(ctx, &pb.DisableClientMsg{ClientId: "unknown-app"})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestRotatePublicClientSecret(t *testing.T) {
+	s, _, _ := testServer(t)
+	s.AdminKey = "admin-key"
+	ctx := adminContext("admin-key")
+
+	reply, err := s.CreateClient(ctx, &pb.CreateClientMsg{ClientId: "mobile-app", GrantTypes: []string{auth.AuthorizationCodeGrant}, RedirectUris: []string{"com.example.app:/callback"}})
+	if err != nil {
+		t.Fatalf("CreateClient() = %v", err)
+	}
+	if reply.Confidential || reply.ClientSecret != "" {
+		t.Fatalf("CreateClient() = %v, want public client", reply)
+	}
+
+	_, err = s.RotateClientSecret(ctx, &pb.RotateClientSecretMsg{ClientId: "mobile-app"})
+	assertCode(t, err, codes.FailedPrecondition)
+}
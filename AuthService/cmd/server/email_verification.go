@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/database"
+	"AuthService/internal/model"
+	"AuthService/internal/notify"
+	"AuthService/source/utils"
+
+	pb "Proto"
+)
+
+const (
+	allowUnverifiedPolicy  = "allow"
+	rejectUnverifiedPolicy = "reject"
+)
+
+// email_verification_config Policy tells what to do with users whose email is not verified:
+// allow issues tokens with email_verified=false claim, reject refuses login and refresh.
+// Limit is how many emails can be requested for one address during Window.
+type email_verification_config struct {
+	TTL    time.Duration
+	URL    string
+	Policy string
+	Limit  int
+	Window time.Duration
+}
+
+// SendVerificationEmail works without access token, because with reject policy unverified user can't get one.
+// Like RequestPasswordReset it answers the same way for any email, sends in background and is limited per email.
+func (s *server) SendVerificationEmail(ctx context.Context, user_request *pb.SendVerificationEmailMsg) (*emptypb.Empty, error) {
+	email, err := auth.NormalizeEmail(user_request.Email)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	allowed, retry_after, err := s.RateLimiter.Allow(ctx, "email_verification:"+utils.HashToken(email), s.Verification.Limit, s.Verification.Window)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check rate limit")
+	}
+	if !allowed {
+		log.Infof("verification email rate limit reached")
+		return nil, rateLimitError(retry_after)
+	}
+
+	go func() {
+		user, err := s.MainDB.SearchUserByIdentifier(email)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Infof("verification email requested for unknown email")
+			}
+			return
+		}
+		s.sendVerificationEmail(context.Background(), user)
+	}()
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) sendVerificationEmail(ctx context.Context, user *model.User) {
+	if user.Email == nil || user.EmailVerified {
+		return
+	}
+
+	link, err := s.actionLink(auth.EmailVerificationPurpose, user.GUID, s.Verification.TTL, s.Verification.URL)
+	if err != nil {
+		return
+	}
+
+	message := &notify.Message{
+		Kind:    auth.EmailVerificationPurpose,
+		To:      *user.Email,
+		Subject: "Confirm your email",
+		Body:    fmt.Sprintf("Open the link to confirm your email. It is valid for %v and can be used once.", s.Verification.TTL),
+		Link:    link,
+	}
+	if err := s.Notifier.Notify(ctx, message); err != nil {
+		log.Errorf("failed to send verification email to %v: %v", user.GUID, err)
+	}
+}
+
+// VerifyEmail marks email verified. Access tokens issued before keep email_verified=false until refresh.
+func (s *server) VerifyEmail(ctx context.Context, user_request *pb.VerifyEmailMsg) (*emptypb.Empty, error) {
+	invalid_token := status.Error(codes.InvalidArgument, "verification token is invalid or expired")
+
+	selector, _, err := auth.SplitActionToken(user_request.Token)
+	if err != nil {
+		return nil, invalid_token
+	}
+
+	action_token, err := s.MainDB.SearchActionToken(auth.EmailVerificationPurpose, selector)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid_token
+		}
+		return nil, status.Error(codes.Internal, "failed to search verification token")
+	}
+	if !s.ActionTokens.VerifyActionToken(user_request.Token, action_token) {
+		return nil, invalid_token
+	}
+
+	if err := s.MainDB.VerifyEmail(action_token); err != nil {
+		if errors.Is(err, database.ErrActionTokenUsed) {
+			return nil, invalid_token
+		}
+		return nil, status.Error(codes.Internal, "failed to verify email")
+	}
+	log.Infof("email of %v verified", action_token.UserGUID)
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkEmailVerified applies UNVERIFIED_EMAIL_POLICY, users without email are not affected.
+// Error has ErrorInfo with EMAIL_NOT_VERIFIED reason, so client can offer to resend verification email.
+func (s *server) checkEmailVerified(user *model.User) error {
+	if s.Verification.Policy != rejectUnverifiedPolicy || user.Email == nil || user.EmailVerified {
+		return nil
+	}
+
+	unverified_status := status.New(codes.FailedPrecondition, "email is not verified")
+	with_details, err := unverified_status.WithDetails(&errdetails.ErrorInfo{
+		Reason: "EMAIL_NOT_VERIFIED",
+		Domain: "auth-service",
+	})
+	if err != nil {
+		log.Errorf("failed to add error details: %v", err)
+		return unverified_status.Err()
+	}
+
+	return with_details.Err()
+}
+
+func newEmailVerificationConfig() *email_verification_config {
+	ttl, err := strconv.Atoi(utils.GetKeyFromEnv("EMAIL_VERIFICATION_TTL"))
+	if err != nil || ttl <= 0 {
+		log.Fatalf("invalid EMAIL_VERIFICATION_TTL: %v", utils.GetKeyFromEnv("EMAIL_VERIFICATION_TTL"))
+	}
+
+	policy := utils.GetKeyFromEnv("UNVERIFIED_EMAIL_POLICY")
+	if policy != allowUnverifiedPolicy && policy != rejectUnverifiedPolicy {
+		log.Fatalf("invalid UNVERIFIED_EMAIL_POLICY: %v", policy)
+	}
+
+	limit, err := strconv.Atoi(utils.GetKeyFromEnv("EMAIL_VERIFICATION_RATE_LIMIT"))
+	if err != nil || limit <= 0 {
+		log.Fatalf("invalid EMAIL_VERIFICATION_RATE_LIMIT: %v", utils.GetKeyFromEnv("EMAIL_VERIFICATION_RATE_LIMIT"))
+	}
+
+	window, err := strconv.Atoi(utils.GetKeyFromEnv("EMAIL_VERIFICATION_RATE_WINDOW"))
+	if err != nil || window <= 0 {
+		log.Fatalf("invalid EMAIL_VERIFICATION_RATE_WINDOW: %v", utils.GetKeyFromEnv("EMAIL_VERIFICATION_RATE_WINDOW"))
+	}
+
+	return &email_verification_config{
+		TTL:    time.Duration(ttl) * time.Minute,
+		URL:    utils.GetKeyFromEnv("EMAIL_VERIFICATION_URL"),
+		Policy: policy,
+		Limit:  limit,
+		Window: time.Duration(window) * time.Minute,
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"AuthService/internal/model"
+
+	pb "Proto"
+)
+
+func TestVerifyEmail(t *testing.T) {
+	s, db, _ := testServer(t)
+	notifier := s.Notifier.(*fakeNotifier)
+	email := "user@example.com"
+	db.users["user-guid"] = &model.User{GUID: "user-guid", Email: &email}
+	user, _ := db.GetUser("user-guid")
+
+	s.sendVerificationEmail(requestContext("", "agent"), user)
+	first_token := notifier.lastLinkParam(t, "token")
+	//new link replaces the previous one
+	s.sendVerificationEmail(requestContext("", "agent"), user)
+	token := notifier.lastLinkParam(t, "token")
+	if len(notifier.messages) != 2 || notifier.messages[1].To != email {
+		t.Fatalf("sent %v messages, want 2 to %v", len(notifier.messages), email)
+	}
+
+	_, err := s.VerifyEmail(requestContext("", "agent"), &pb.VerifyEmailMsg{Token: first_token})
+	assertCode(t, err, codes.InvalidArgument)
+	_, err = s.VerifyEmail(requestContext("", "agent"), &pb.VerifyEmailMsg{Token: token + "x"})
+	assertCode(t, err, codes.InvalidArgument)
+
+	if _, err := s.VerifyEmail(requestContext("", "agent"), &pb.VerifyEmailMsg{Token: token}); err != nil {
+		t.Fatalf("VerifyEmail() = %v", err)
+	}
+	if !db.users["user-guid"].EmailVerified {
+		t.Errorf("email is not verified")
+	}
+
+	//link works once
+	_, err = s.VerifyEmail(requestContext("", "agent"), &pb.VerifyEmailMsg{Token: token})
+	assertCode(t, err, codes.InvalidArgument)
+
+	//verified email doesn't get more links
+	user, _ = db.GetUser("user-guid")
+	s.sendVerificationEmail(requestContext("", "agent"), user)
+	if len(notifier.messages) != 2 {
+		t.Errorf("link is sent for verified email")
+	}
+}
+
+func TestUnverifiedEmailPolicy(t *testing.T) {
+	email := "user@example.com"
+	tests := []struct {
+		name   string
+		policy string
+		user   *model.User
+		code   codes.Code
+	}{
+		{"allow unverified", allowUnverifiedPolicy, &model.User{GUID: "user-guid", Email: &email}, codes.OK},
+		{"reject unverified", rejectUnverifiedPolicy, &model.User{GUID: "user-guid", Email: &email}, codes.FailedPrecondition},
+		{"reject verified", rejectUnverifiedPolicy, &model.User{GUID: "user-guid", Email: &email, EmailVerified: true}, codes.OK},
+		{"reject user without email", rejectUnverifiedPolicy, &model.User{GUID: "user-guid"}, codes.OK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, _, _ := testServer(t)
+			s.Verification.Policy = test.policy
+			_, err := s.createSession(requestContext("", "agent"), test.user, "", nil, "", "", "agent", "127.0.0.1")
+			assertCode(t, err, test.code)
+		})
+	}
+}
+
+func TestSendVerificationEmailRateLimit(t *testing.T) {
+	s, _, _ := testServer(t)
+
+	for attempt := 1; attempt <= s.Verification.Limit; attempt++ {
+		if _, err := s.SendVerificationEmail(requestContext("", "agent"), &pb.SendVerificationEmailMsg{Email: "unknown@example.com"}); err != nil {
+			t.Fatalf("attempt %v: SendVerificationEmail() = %v", attempt, err)
+		}
+	}
+	_, err := s.SendVerificationEmail(requestContext("", "agent"), &pb.SendVerificationEmailMsg{Email: "unknown@example.com"})
+	assertCode(t, err, codes.ResourceExhausted)
+
+	//password reset is counted separately
+	if _, err := s.RequestPasswordReset(requestContext("", "agent"), &pb.RequestPasswordResetMsg{Email: "unknown@example.com"}); err != nil {
+		t.Errorf("RequestPasswordReset() = %v", err)
+	}
+}
@@ -1,25 +1,31 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
-	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
 
 	"AuthService/internal/auth"
 	"AuthService/internal/database"
 	"AuthService/internal/model"
+	"AuthService/internal/notify"
+	"AuthService/internal/policy"
+	"AuthService/internal/worker"
 	"AuthService/source/utils"
 
 	pb "Proto"
@@ -32,40 +38,129 @@ type (
 		AuthManager      auth.AuthManager
 		RefreshManager   auth.RefreshManager
 		BlacklistManager database.BlacklistManager
+		SessionLimit     *auth.SessionLimit
+		PasswordHasher   auth.PasswordHasher
+		PasswordPolicy   *policy.PasswordPolicy
+		ActionTokens     auth.ActionTokenManager
+		Notifier         notify.Notifier
+		PasswordReset    *password_reset_config
+		Verification     *email_verification_config
+		TOTPManager      auth.TOTPManager
+		MFA              *mfa_config
+		PasskeyManager   auth.PasskeyManager
+		RateLimiter      database.RateLimiter
+		Passwordless     *passwordless_config
+		ClientSecrets    auth.ClientSecretManager
+		OAuth            *oauth_config
+		AdminKey         string
+		TrustedMode      bool
+		dummy_hash       string
 	}
 )
 
-func NewServer(main_db database.Database, auth_manager auth.AuthManager, refresh_manager auth.RefreshManager, blacklist_manager database.BlacklistManager) *server {
-	return &server{MainDB: main_db, AuthManager: auth_manager, RefreshManager: refresh_manager, BlacklistManager: blacklist_manager}
+func NewServer(main_db database.Database, auth_manager auth.AuthManager, refresh_manager auth.RefreshManager, blacklist_manager database.BlacklistManager, session_limit *auth.SessionLimit, password_hasher auth.PasswordHasher, password_policy *policy.PasswordPolicy, action_tokens auth.ActionTokenManager, notifier notify.Notifier, password_reset *password_reset_config, verification *email_verification_config, totp_manager auth.TOTPManager, mfa *mfa_config, passkey_manager auth.PasskeyManager, rate_limiter database.RateLimiter, passwordless *passwordless_config, client_secrets auth.ClientSecretManager, oauth *oauth_config, admin_key string, trusted_mode bool) *server {
+	//hash of random password is verified when user is not found, so response time doesn't tell if user exists
+	dummy_hash, err := password_hasher.HashPassword(uuid.New().String())
+	if err != nil {
+		log.Fatalf("failed to prepare dummy password hash: %v", err)
+	}
+
+	return &server{MainDB: main_db, AuthManager: auth_manager, RefreshManager: refresh_manager, BlacklistManager: blacklist_manager, SessionLimit: session_limit, PasswordHasher: password_hasher, PasswordPolicy: password_policy, ActionTokens: action_tokens, Notifier: notifier, PasswordReset: password_reset, Verification: verification, TOTPManager: totp_manager, MFA: mfa, PasskeyManager: passkey_manager, RateLimiter: rate_limiter, Passwordless: passwordless, ClientSecrets: client_secrets, OAuth: oauth, AdminKey: admin_key, TrustedMode: trusted_mode, dummy_hash: dummy_hash}
 }
 
+// GetTokens issues tokens by bare guid without any credentials, so it works only in trusted mode
+// when service is reachable from internal network only
 func (s *server) GetTokens(ctx context.Context, user_request *pb.GetTokensMsg) (*pb.GetTokensReply, error) {
-	if err := s.MainDB.SearchGUID(user_request.Guid); err != nil {
+	if !s.TrustedMode {
+		return nil, status.Error(codes.PermissionDenied, "login by guid is available only in trusted mode, use login")
+	}
+
+	if auth.HasScope(user_request.Scope, auth.OpenIDScope) && user_request.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_id is required for openid scope")
+	}
+
+	user, err := s.MainDB.GetUser(user_request.Guid)
+	if err != nil {
 		return nil, status.Error(codes.NotFound, "GUID not found")
 	}
 
+	user_agent, user_ip, err := clientInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(ctx, user, user_request.Scope, user_request.ClientId, user_request.Nonce, user_agent, user_ip)
+}
+
+// clientInfo returns user-agent and ip which are stored in session and checked on refresh
+func clientInfo(ctx context.Context) (string, string, error) {
 	user_agent, err := utils.GetFromMetadata(ctx, "x-user-agent")
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "user-agent header not provided")
+		return "", "", status.Error(codes.InvalidArgument, "user-agent header not provided")
 	}
 
 	user_ip, err := utils.GetFromMetadata(ctx, "x-forwarded-for")
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "x-forwarder-for header not provided")
+		return "", "", status.Error(codes.InvalidArgument, "x-forwarder-for header not provided")
 	}
 
-	session_id, refresh, err := s.MainDB.AddSession(user_request.Guid, s.RefreshManager, user_agent, user_ip)
+	return user_agent, user_ip, nil
+}
+
+// issueTokens creates first-party session of authenticated user and returns its token pair, with openid scope
+// ID token is added. client_id of first-party requests is not authenticated, so it is only audience of ID token
+// and has to be public client. Tokens bound to client are issued only by Token endpoint, see issueClientTokens.
+func (s *server) issueTokens(ctx context.Context, user *model.User, scope string, client_id string, nonce string, user_agent string, user_ip string) (*pb.GetTokensReply, error) {
+	client, err := s.searchClient(client_id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to add session in db")
+		return nil, err
+	}
+	if client != nil && auth.IsConfidential(client) {
+		return nil, status.Error(codes.PermissionDenied, "confidential client has to get tokens from oauth token endpoint")
+	}
+	if client != nil && !auth.AllowsScope(client, scope) {
+		return nil, status.Error(codes.InvalidArgument, "scope is not allowed for client")
 	}
 
-	access, err := s.AuthManager.GenerateToken(&model.User{GUID: user_request.Guid}, session_id)
+	return s.createSession(ctx, user, scope, nil, client_id, nonce, user_agent, user_ip)
+}
+
+// issueClientTokens creates session of client authenticated by Token endpoint, session remembers client
+// and tokens get its lifetimes and client_id claim
+func (s *server) issueClientTokens(ctx context.Context, user *model.User, scope string, client *model.OAuthClient, nonce string, user_agent string, user_ip string) (*pb.GetTokensReply, error) {
+	return s.createSession(ctx, user, scope, client, client.ID, nonce, user_agent, user_ip)
+}
+
+// createSession issues tokens of session bound to client, nil client means first-party session.
+// audience is audience of ID token.
+func (s *server) createSession(ctx context.Context, user *model.User, scope string, client *model.OAuthClient, audience string, nonce string, user_agent string, user_ip string) (*pb.GetTokensReply, error) {
+	if err := s.checkEmailVerified(user); err != nil {
+		return nil, err
+	}
+
+	client_id := ""
+	if client != nil {
+		client_id = client.ID
+	}
+	session_id, refresh, err := s.addSessionWithinLimit(ctx, user.GUID, client_id, scope, s.refreshLifeTime(client), user_agent, user_ip)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := s.AuthManager.GenerateToken(user, session_id, client_id, scope, s.accessLifeTime(client))
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to generate access token")
 	}
 
-	return &pb.GetTokensReply{Access: access, Refresh: refresh}, nil
+	reply := &pb.GetTokensReply{Access: access, Refresh: refresh}
+	if auth.HasScope(scope, auth.OpenIDScope) {
+		reply.IdToken, err = s.AuthManager.GenerateIDToken(user, session_id, audience, nonce)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to generate id token")
+		}
+	}
 
+	return reply, nil
 }
 
 func (s *server) RefreshTokens(ctx context.Context, user_request *pb.RefreshTokensMsg) (*pb.RefreshTokensReply, error) {
@@ -85,21 +180,23 @@ func (s *server) RefreshTokens(ctx context.Context, user_request *pb.RefreshToke
 		return nil, status.Error(codes.InvalidArgument, "x-forwarder-for header not provided")
 	}
 
-	is_blacklisted, err := s.BlacklistManager.BlacklistCheck(ctx, access)
+	claims, err := s.AuthManager.VerifyToken(access, false)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed blacklist check")
+		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
-	if is_blacklisted {
-		return nil, status.Error(codes.Unauthenticated, "token is blacklisted")
+
+	//replayed pair has blacklisted access token, so reuse is detected before the blacklist check
+	if err := s.detectRefreshReuse(ctx, user_request.Refresh, user_ip, user_agent); err != nil {
+		return nil, err
 	}
 
-	claims, err := s.AuthManager.VerifyToken(access, false)
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+	if err := s.checkRevoked(ctx, claims); err != nil {
+		return nil, err
 	}
 
 	user_guid := claims.GUID
-	if err := s.MainDB.SearchGUID(user_guid); err != nil {
+	user, err := s.MainDB.GetUser(user_guid)
+	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "Guid not found")
 	}
 
@@ -108,12 +205,13 @@ func (s *server) RefreshTokens(ctx context.Context, user_request *pb.RefreshToke
 		return nil, status.Error(codes.Unauthenticated, "cannot find session")
 	}
 
+	//client sessions are refreshed by Token endpoint, where the client is authenticated
+	if session.ClientID != "" {
+		return nil, status.Error(codes.PermissionDenied, "session of oauth client is refreshed by token endpoint")
+	}
+
 	if session.UserAgent != user_agent {
-		token, err := auth.ExtractToken(access)
-		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, err.Error())
-		}
-		if err := s.BlacklistManager.AddToBlacklist(token, claims.ExpiresAt, ctx); err != nil {
+		if err := s.BlacklistManager.AddToBlacklist(ctx, claims); err != nil {
 			return nil, status.Error(codes.Internal, "failed to add token to blacklist")
 		}
 
@@ -133,45 +231,40 @@ func (s *server) RefreshTokens(ctx context.Context, user_request *pb.RefreshToke
 			"sessionId": strconv.Itoa(int(session.ID)),
 		}
 
-		webhook := utils.GetKeyFromEnv("WEBHOOK_URL")
-		json_data, err := json.Marshal(data)
-		if err != nil {
-			return nil, status.Error(codes.Internal, "failed marshal data")
-		}
-
-		response, err := http.Post(webhook, "application/json", bytes.NewBuffer(json_data))
-		if err != nil {
-			log.Errorf("failed to send to webhook: %v", err)
+		if err := utils.SendToWebhook(data); err != nil {
 			return nil, status.Error(codes.Internal, "failed send to webhook")
 		}
-		log.Print("message send to webhook")
-		response.Body.Close()
 	}
-	err = utils.CompareHashAndPassword(user_request.Refresh, session.Refresh)
-	if err != nil {
+	if !s.RefreshManager.VerifyRefresh(user_request.Refresh, session) {
 		return nil, status.Error(codes.Unauthenticated, "refresh token is invalid")
 	}
-	//generating process
-	err = s.MainDB.DeleteSession(session.UserGUID, session.ID)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to delete session")
-	}
 
-	token, err := auth.ExtractToken(access)
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+	if err := s.RefreshManager.CheckExpiry(session); err != nil {
+		if err := s.MainDB.DeleteSession(session.UserGUID, session.ID); err != nil {
+			return nil, status.Error(codes.Internal, "failed to delete session")
+		}
+		return nil, sessionExpiredError(err)
+	}
+	if err := s.checkEmailVerified(user); err != nil {
+		return nil, err
 	}
-	err = s.BlacklistManager.AddToBlacklist(token, claims.ExpiresAt, ctx)
+	//generating process
+	session_id, new_refresh, err := s.MainDB.RotateSession(session, user_request.Refresh, s.RefreshManager, s.refreshLifeTime(nil), user_agent, user_ip)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to add token to blacklist")
+		//concurrent refresh with the same token already rotated the session. Such race is made by client itself,
+		//e.g. by two tabs, so family is not revoked; token presented after the rotation is caught as reuse above
+		if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, database.ErrRefreshMismatch) {
+			return nil, status.Error(codes.Unauthenticated, "refresh token is invalid")
+		}
+		return nil, status.Error(codes.Internal, "failed to rotate session")
 	}
 
-	session_id, new_refresh, err := s.MainDB.AddSession(claims.GUID, s.RefreshManager, user_agent, user_ip)
+	err = s.BlacklistManager.AddToBlacklist(ctx, claims)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to add session in db")
+		return nil, status.Error(codes.Internal, "failed to add token to blacklist")
 	}
 
-	new_access, err := s.AuthManager.GenerateToken(&model.User{GUID: claims.GUID}, session_id)
+	new_access, err := s.AuthManager.GenerateToken(user, session_id, "", session.Scope, s.accessLifeTime(nil))
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to generate access token")
 	}
@@ -180,6 +273,16 @@ func (s *server) RefreshTokens(ctx context.Context, user_request *pb.RefreshToke
 }
 
 func (s *server) GetGUID(ctx context.Context, _ *emptypb.Empty) (*pb.GetGUIDReply, error) {
+	claims, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetGUIDReply{Guid: claims.GUID}, nil
+}
+
+// authenticate verifies access token from authorization header and checks that it is not revoked
+func (s *server) authenticate(ctx context.Context) (*auth.TokenClaims, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
@@ -196,15 +299,88 @@ func (s *server) GetGUID(ctx context.Context, _ *emptypb.Empty) (*pb.GetGUIDRepl
 		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
 
-	is_blacklisted, err := s.BlacklistManager.BlacklistCheck(ctx, token)
+	if err := s.checkRevoked(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// sessionExpiredError has ErrorInfo with SESSION_EXPIRED reason, so client knows that refresh is useless and user has to login again
+func sessionExpiredError(expiry_error error) error {
+	expiry := "absolute"
+	if errors.Is(expiry_error, auth.ErrRefreshIdle) {
+		expiry = "idle"
+	}
+
+	expired_status := status.New(codes.Unauthenticated, fmt.Sprintf("%v, login again", expiry_error))
+	with_details, err := expired_status.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "SESSION_EXPIRED",
+		Domain:   "auth-service",
+		Metadata: map[string]string{"expiry": expiry},
+	})
+	if err != nil {
+		log.Errorf("failed to add error details: %v", err)
+		return expired_status.Err()
+	}
+
+	return with_details.Err()
+}
+
+// checkRevoked rejects access token which was blacklisted by itself, together with its session or all tokens of user
+func (s *server) checkRevoked(ctx context.Context, claims *auth.TokenClaims) error {
+	is_blacklisted, err := s.BlacklistManager.BlacklistCheck(ctx, claims)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed blacklist check")
+		return status.Error(codes.Internal, "failed blacklist check")
 	}
 	if is_blacklisted {
-		return nil, status.Error(codes.Unauthenticated, "token is blacklisted")
+		return status.Error(codes.Unauthenticated, "token is blacklisted")
 	}
 
-	return &pb.GetGUIDReply{Guid: claims.GUID}, nil
+	return nil
+}
+
+// detectRefreshReuse checks if refresh token was already rotated. Such token can be presented only
+// after it was stolen, so the whole family of sessions is revoked together with their access tokens.
+func (s *server) detectRefreshReuse(ctx context.Context, refresh string, user_ip string, user_agent string) error {
+	used, err := s.MainDB.SearchUsedRefresh(refresh)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return status.Error(codes.Internal, "failed to search used refresh token")
+	}
+
+	sessions, err := s.MainDB.DeleteSessionFamily(used.FamilyID)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to delete session family")
+	}
+
+	access_expiry, err := s.accessExpiry()
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if err := s.BlacklistManager.RevokeSession(ctx, session.ID, access_expiry); err != nil {
+			return status.Error(codes.Internal, "failed to revoke session")
+		}
+	}
+
+	log.Warnf("refresh token reuse detected for %v, family %v revoked", used.UserGUID, used.FamilyID)
+	data := map[string]string{
+		"message":         "refresh token reuse detected, all sessions of the family are revoked",
+		"guid":            used.UserGUID,
+		"familyId":        used.FamilyID,
+		"reusedSessionId": strconv.Itoa(int(used.SessionID)),
+		"revokedSessions": strconv.Itoa(len(sessions)),
+		"ip":              user_ip,
+		"userAgent":       user_agent,
+	}
+	if err := utils.SendToWebhook(data); err != nil {
+		log.Errorf("failed to send reuse event: %v", err)
+	}
+
+	return status.Error(codes.Unauthenticated, "refresh token reuse detected, session revoked")
 }
 
 func (s *server) Logout(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
@@ -218,11 +394,7 @@ func (s *server) Logout(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty,
 		return nil, err
 	}
 
-	token, err = auth.ExtractToken(token)
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
-	}
-	if err := s.BlacklistManager.AddToBlacklist(token, claims.ExpiresAt, ctx); err != nil {
+	if err := s.BlacklistManager.AddToBlacklist(ctx, claims); err != nil {
 		return nil, status.Error(codes.Internal, "failed to add token to blacklist")
 	}
 
@@ -233,6 +405,10 @@ func (s *server) Logout(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty,
 }
 
 func (s *server) AddUser(context.Context, *emptypb.Empty) (*pb.AddUserReply, error) {
+	if !s.TrustedMode {
+		return nil, status.Error(codes.PermissionDenied, "adding user without credentials is available only in trusted mode, use register")
+	}
+
 	guid, err := s.MainDB.AddUser()
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to add user to database")
@@ -240,20 +416,96 @@ func (s *server) AddUser(context.Context, *emptypb.Empty) (*pb.AddUserReply, err
 	return &pb.AddUserReply{Guid: guid}, nil
 }
 
+func (s *server) GetJWKS(ctx context.Context, _ *emptypb.Empty) (*httpbody.HttpBody, error) {
+	body, err := jsonBody(map[string][]*auth.JWK{"keys": s.AuthManager.PublicKeys()})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to marshal jwks")
+	}
+
+	setCacheControl(ctx, fmt.Sprintf("public, max-age=%v", utils.GetKeyFromEnv("JWKS_CACHE_MAX_AGE")))
+	return body, nil
+}
+
+func (s *server) GetOpenIDConfiguration(ctx context.Context, _ *emptypb.Empty) (*httpbody.HttpBody, error) {
+	document := auth.NewDiscoveryDocument(utils.GetKeyFromEnv("JWT_ISSUER"), s.AuthManager.SigningAlgorithms())
+	body, err := jsonBody(document)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to marshal openid configuration")
+	}
+
+	setCacheControl(ctx, fmt.Sprintf("public, max-age=%v", utils.GetKeyFromEnv("JWKS_CACHE_MAX_AGE")))
+	return body, nil
+}
+
+// jsonBody is used by endpoints which must return plain json defined by RFCs instead of proto messages
+func jsonBody(data interface{}) (*httpbody.HttpBody, error) {
+	json_data, err := json.Marshal(data)
+	if err != nil {
+		log.Errorf("failed to marshal response: %v", err)
+		return nil, err
+	}
+
+	return &httpbody.HttpBody{ContentType: "application/json", Data: json_data}, nil
+}
+
+func setCacheControl(ctx context.Context, value string) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs("cache-control", value)); err != nil {
+		log.Errorf("failed to set cache-control header: %v", err)
+	}
+}
+
 func main() {
 	utils.LoadEnvFile()
 	port, _ := strconv.Atoi(utils.GetKeyFromEnv("AUTH_HOST_PORT"))
 	access_life_time, _ := strconv.Atoi(utils.GetKeyFromEnv("ACCESS_LIFE_TIME"))
 	resresh_life_time, _ := strconv.Atoi(utils.GetKeyFromEnv("REFRESH_LIFE_TIME"))
+	refresh_idle_time, _ := strconv.Atoi(utils.GetKeyFromEnv("REFRESH_IDLE_TIME"))
 	refresh_length, _ := strconv.Atoi(utils.GetKeyFromEnv("REFRESH_LENGTH"))
+	refresh_pepper := utils.GetKeyFromEnv("REFRESH_PEPPER")
+	key_reload_interval, _ := strconv.Atoi(utils.GetKeyFromEnv("JWT_KEY_RELOAD_INTERVAL"))
 	
 	log.Info("New Version is running")
 
 	db := database.InitDataBase()
 	main_db := database.NewPostgresDB(db)
 	auth_manager := auth.NewJWTManager(time.Duration(access_life_time) * time.Minute)
-	refresh_manager := auth.NewRefreshGenerator(int64(refresh_length), (time.Duration(resresh_life_time)*time.Hour))
+	refresh_manager := auth.NewRefreshGenerator(int64(refresh_length), (time.Duration(resresh_life_time)*time.Hour), time.Duration(refresh_idle_time)*time.Hour, refresh_pepper)
+	if err := main_db.MigrateLegacySessions(refresh_manager.GetExparationTime()); err != nil {
+		log.Fatalf("failed to migrate sessions: %v", err)
+	}
 	blacklist_manager := database.NewRedisManager()
+	session_limit := auth.NewSessionLimit()
+	password_hasher := auth.NewArgon2Hasher()
+	password_policy := policy.NewPasswordPolicy()
+	action_tokens := auth.NewActionTokenManager(utils.GetKeyFromEnv("ACTION_TOKEN_PEPPER"))
+	totp_manager := auth.NewTOTPManager()
+	passkey_manager := auth.NewPasskeyManager()
+	client_secrets := auth.NewClientSecretManager(utils.GetKeyFromEnv("CLIENT_SECRET_PEPPER"))
+	admin_key := utils.GetKeyFromEnv("ADMIN_KEY")
+	if admin_key == "" {
+		log.Warn("ADMIN_KEY is empty, admin api is disabled")
+	}
+	notifier, err := notify.NewNotifier()
+	if err != nil {
+		log.Fatalf("failed to init notifier: %v", err)
+	}
+	trusted_mode, err := strconv.ParseBool(utils.GetKeyFromEnv("TRUSTED_MODE"))
+	if err != nil {
+		log.Fatalf("invalid TRUSTED_MODE: %v", err)
+	}
+	if trusted_mode {
+		log.Warn("trusted mode is on, tokens are issued by bare guid, don't expose the service publicly")
+	}
+	session_reaper := worker.NewSessionReaper(main_db, refresh_manager.GetIdleTime())
+
+	go auth_manager.Keys.WatchReload(time.Duration(key_reload_interval)*time.Second, func() (*auth.SigningKey, error) {
+		if err := utils.ReloadEnvFile(); err != nil {
+			return nil, err
+		}
+		return auth.LoadKeyFromEnv()
+	})
+
+	go session_reaper.Run(context.Background())
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
@@ -265,7 +517,7 @@ func main() {
 		log.Fatalf("failed to initialize interceptor: %v", err)
 	}
 
-	server := NewServer(main_db, auth_manager, refresh_manager, blacklist_manager)
+	server := NewServer(main_db, auth_manager, refresh_manager, blacklist_manager, session_limit, password_hasher, password_policy, action_tokens, notifier, newPasswordResetConfig(), newEmailVerificationConfig(), totp_manager, newMFAConfig(), passkey_manager, blacklist_manager, newPasswordlessConfig(), client_secrets, newOAuthConfig(), admin_key, trusted_mode)
 	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterAuthServer(grpcServer, server)
 	log.Printf("Server listening on: %v", lis.Addr())
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "Proto"
+)
+
+func TestRefreshTokens(t *testing.T) {
+	s, db, _ := testServer(t)
+	session_id, access, refresh := login(t, s, db, "user-guid", "agent")
+
+	reply, err := s.RefreshTokens(requestContext(access, "agent"), &pb.RefreshTokensMsg{Refresh: refresh})
+	if err != nil {
+		t.Fatalf("RefreshTokens() = %v", err)
+	}
+	if reply.Access == access || reply.Refresh == refresh {
+		t.Fatalf("RefreshTokens() returned the same tokens")
+	}
+
+	//old pair is replaced by the new one
+	if _, err := db.SearchSession("user-guid", session_id); err == nil {
+		t.Errorf("old session %v is not replaced", session_id)
+	}
+	_, err = s.GetGUID(requestContext(access, "agent"), &emptypb.Empty{})
+	assertCode(t, err, codes.Unauthenticated)
+	if _, err := s.GetGUID(requestContext(reply.Access, "agent"), &emptypb.Empty{}); err != nil {
+		t.Errorf("new access token is rejected: %v", err)
+	}
+	if _, err := s.RefreshTokens(requestContext(reply.Access, "agent"), &pb.RefreshTokensMsg{Refresh: reply.Refresh}); err != nil {
+		t.Errorf("new pair can't be refreshed: %v", err)
+	}
+}
+
+func TestRefreshTokensReuse(t *testing.T) {
+	s, db, blacklist := testServer(t)
+	messages := testWebhook(t)
+	_, access, refresh := login(t, s, db, "user-guid", "agent")
+	other_id, _, _ := login(t, s, db, "user-guid", "agent")
+
+	reply, err := s.RefreshTokens(requestContext(access, "agent"), &pb.RefreshTokensMsg{Refresh: refresh})
+	if err != nil {
+		t.Fatalf("RefreshTokens() = %v", err)
+	}
+
+	//rotated pair presented again was stolen, the whole family is revoked
+	_, err = s.RefreshTokens(requestContext(access, "agent"), &pb.RefreshTokensMsg{Refresh: refresh})
+	assertCode(t, err, codes.Unauthenticated)
+
+	claims, err := s.AuthManager.VerifyToken("Bearer "+reply.Access, true)
+	if err != nil {
+		t.Fatalf("VerifyToken() = %v", err)
+	}
+	if _, err := db.SearchSession("user-guid", claims.SessionId); err == nil || !blacklist.sessions[claims.SessionId] {
+		t.Errorf("session %v of the family is not revoked", claims.SessionId)
+	}
+	if _, err := db.SearchSession("user-guid", other_id); err != nil {
+		t.Errorf("session %v of another login is revoked", other_id)
+	}
+	if len(*messages) != 1 || (*messages)[0]["guid"] != "user-guid" {
+		t.Errorf("webhook got %v, want reuse event", *messages)
+	}
+}
+
+func TestRefreshTokensConcurrent(t *testing.T) {
+	s, db, blacklist := testServer(t)
+	messages := testWebhook(t)
+	_, access, refresh := login(t, s, db, "user-guid", "agent")
+
+	//the same pair is refreshed by another request while this one is checking the session
+	var winner *pb.RefreshTokensReply
+	db.before_rotate = func() {
+		var err error
+		winner, err = s.RefreshTokens(requestContext(access, "agent"), &pb.RefreshTokensMsg{Refresh: refresh})
+		if err != nil {
+			t.Fatalf("concurrent RefreshTokens() = %v", err)
+		}
+	}
+
+	_, err := s.RefreshTokens(requestContext(access, "agent"), &pb.RefreshTokensMsg{Refresh: refresh})
+	assertCode(t, err, codes.Unauthenticated)
+
+	//lost race is not a reuse, the session of the winner stays
+	claims, err := s.AuthManager.VerifyToken("Bearer "+winner.Access, true)
+	if err != nil {
+		t.Fatalf("VerifyToken() = %v", err)
+	}
+	if _, err := db.SearchSession("user-guid", claims.SessionId); err != nil || blacklist.sessions[claims.SessionId] {
+		t.Errorf("session %v of the winner is revoked", claims.SessionId)
+	}
+	if len(*messages) != 0 {
+		t.Errorf("webhook got %v", *messages)
+	}
+	if _, err := s.RefreshTokens(requestContext(winner.Access, "agent"), &pb.RefreshTokensMsg{Refresh: winner.Refresh}); err != nil {
+		t.Errorf("pair of the winner can't be refreshed: %v", err)
+	}
+}
+
+func TestRefreshTokensUserAgentChanged(t *testing.T) {
+	s, db, _ := testServer(t)
+	session_id, access, refresh := login(t, s, db, "user-guid", "agent")
+
+	_, err := s.RefreshTokens(requestContext(access, "another agent"), &pb.RefreshTokensMsg{Refresh: refresh})
+	assertCode(t, err, codes.Unauthenticated)
+	if _, err := db.SearchSession("user-guid", session_id); err == nil {
+		t.Errorf("session %v is not deauthorized", session_id)
+	}
+}
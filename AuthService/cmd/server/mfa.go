@@ -0,0 +1,289 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/database"
+	"AuthService/internal/model"
+	"AuthService/source/utils"
+
+	pb "Proto"
+)
+
+// mfa_config MaxAttempts limits wrong codes for one challenge, UserMaxFailures limits wrong codes of user
+// across all challenges during LockoutWindow, so new logins don't give new attempts
+type mfa_config struct {
+	ChallengeTTL    time.Duration
+	MaxAttempts     int
+	UserMaxFailures int
+	LockoutWindow   time.Duration
+}
+
+// EnrollTOTP creates new secret for authenticator app. It starts working only after ConfirmTOTP,
+// until then enrollment can be repeated and previous secret is replaced.
+func (s *server) EnrollTOTP(ctx context.Context, _ *emptypb.Empty) (*pb.EnrollTOTPReply, error) {
+	claims, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.MainDB.GetUser(claims.GUID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	secret, err := s.TOTPManager.GenerateSecret()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate totp secret")
+	}
+	encrypted_secret, err := s.TOTPManager.EncryptSecret(secret)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to encrypt totp secret")
+	}
+
+	if err := s.MainDB.SaveTOTP(user.GUID, encrypted_secret); err != nil {
+		if errors.Is(err, database.ErrTOTPEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, "totp is already enabled")
+		}
+		return nil, status.Error(codes.Internal, "failed to save totp secret")
+	}
+
+	account := user.GUID
+	if user.Email != nil {
+		account = *user.Email
+	} else if user.Username != nil {
+		account = *user.Username
+	}
+
+	return &pb.EnrollTOTPReply{Secret: secret, Uri: s.TOTPManager.URI(account, secret)}, nil
+}
+
+// ConfirmTOTP enables TOTP after the first valid code and returns recovery codes, they are shown only once
+func (s *server) ConfirmTOTP(ctx context.Context, user_request *pb.ConfirmTOTPMsg) (*pb.ConfirmTOTPReply, error) {
+	claims, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := s.MainDB.GetTOTP(claims.GUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "totp enrollment is not started")
+		}
+		return nil, status.Error(codes.Internal, "failed to search totp")
+	}
+	if credential.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "totp is already enabled")
+	}
+
+	secret, err := s.TOTPManager.DecryptSecret(credential.Secret)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to decrypt totp secret")
+	}
+	step, ok := s.TOTPManager.ValidateCode(secret, user_request.Code)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+
+	recovery_codes, recovery_hashes, err := s.TOTPManager.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate recovery codes")
+	}
+
+	if err := s.MainDB.EnableTOTP(claims.GUID, step, recovery_hashes); err != nil {
+		if errors.Is(err, database.ErrTOTPEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, "totp is already enabled")
+		}
+		return nil, status.Error(codes.Internal, "failed to enable totp")
+	}
+	log.Infof("totp enabled for %v", claims.GUID)
+
+	return &pb.ConfirmTOTPReply{RecoveryCodes: recovery_codes}, nil
+}
+
+// mfaChallenge returns challenge token if user has TOTP enabled, empty string means second factor is not needed
+func (s *server) mfaChallenge(user *model.User) (string, error) {
+	credential, err := s.MainDB.GetTOTP(user.GUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", status.Error(codes.Internal, "failed to search totp")
+	}
+	if !credential.Enabled {
+		return "", nil
+	}
+
+	action_token, challenge, err := s.ActionTokens.GenerateActionToken(auth.MFAChallengePurpose, user.GUID, s.MFA.ChallengeTTL)
+	if err != nil {
+		return "", status.Error(codes.Internal, "failed to generate mfa challenge")
+	}
+	if err := s.MainDB.AddActionToken(action_token); err != nil {
+		return "", status.Error(codes.Internal, "failed to save mfa challenge")
+	}
+
+	return challenge, nil
+}
+
+// VerifyMFA is the second step of login. Code is TOTP code or recovery code, challenge survives
+// wrong codes until MFA_MAX_ATTEMPTS is reached, user is locked out after MFA_USER_MAX_FAILURES
+// wrong codes in all challenges.
+func (s *server) VerifyMFA(ctx context.Context, user_request *pb.VerifyMFAMsg) (*pb.GetTokensReply, error) {
+	if auth.HasScope(user_request.Scope, auth.OpenIDScope) && user_request.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_id is required for openid scope")
+	}
+
+	user_agent, user_ip, err := clientInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid_challenge := status.Error(codes.Unauthenticated, "mfa challenge is invalid or expired, login again")
+	selector, _, err := auth.SplitActionToken(user_request.Challenge)
+	if err != nil {
+		return nil, invalid_challenge
+	}
+	action_token, err := s.MainDB.SearchActionToken(auth.MFAChallengePurpose, selector)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid_challenge
+		}
+		return nil, status.Error(codes.Internal, "failed to search mfa challenge")
+	}
+	if !s.ActionTokens.VerifyActionToken(user_request.Challenge, action_token) {
+		return nil, invalid_challenge
+	}
+
+	lockout_key := "mfa:" + action_token.UserGUID
+	locked, retry_after, err := s.RateLimiter.Limited(ctx, lockout_key, s.MFA.UserMaxFailures)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check mfa lockout")
+	}
+	if locked {
+		log.Infof("mfa of %v is locked, attempt from %v", action_token.UserGUID, user_ip)
+		return nil, rateLimitError(retry_after)
+	}
+
+	is_valid, err := s.checkSecondFactor(action_token.UserGUID, user_request.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !is_valid {
+		log.Infof("wrong mfa code for %v from %v", action_token.UserGUID, user_ip)
+		if err := s.MainDB.FailActionToken(action_token, s.MFA.MaxAttempts); err != nil {
+			return nil, status.Error(codes.Internal, "failed to count mfa attempt")
+		}
+		allowed, _, err := s.RateLimiter.Allow(ctx, lockout_key, s.MFA.UserMaxFailures, s.MFA.LockoutWindow)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to count mfa failure")
+		}
+		if !allowed {
+			log.Warnf("mfa of %v locked after %v wrong codes", action_token.UserGUID, s.MFA.UserMaxFailures)
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid code")
+	}
+
+	if err := s.MainDB.UseActionToken(action_token); err != nil {
+		if errors.Is(err, database.ErrActionTokenUsed) {
+			return nil, invalid_challenge
+		}
+		return nil, status.Error(codes.Internal, "failed to use mfa challenge")
+	}
+
+	user, err := s.MainDB.GetUser(action_token.UserGUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to search user")
+	}
+
+	return s.issueTokens(ctx, user, user_request.Scope, user_request.ClientId, user_request.Nonce, user_agent, user_ip)
+}
+
+// checkSecondFactor accepts TOTP code of step after the last used one or unused recovery code
+func (s *server) checkSecondFactor(guid string, code string) (bool, error) {
+	if !auth.IsTOTPCode(code) {
+		err := s.MainDB.UseRecoveryCode(guid, s.TOTPManager.HashRecoveryCode(code))
+		if err != nil {
+			if errors.Is(err, database.ErrRecoveryCode) {
+				return false, nil
+			}
+			return false, status.Error(codes.Internal, "failed to check recovery code")
+		}
+		log.Infof("recovery code used by %v", guid)
+		return true, nil
+	}
+
+	credential, err := s.MainDB.GetTOTP(guid)
+	if err != nil || !credential.Enabled {
+		return false, status.Error(codes.Internal, "failed to search totp")
+	}
+	secret, err := s.TOTPManager.DecryptSecret(credential.Secret)
+	if err != nil {
+		return false, status.Error(codes.Internal, "failed to decrypt totp secret")
+	}
+
+	step, ok := s.TOTPManager.ValidateCode(secret, code)
+	if !ok {
+		return false, nil
+	}
+	if err := s.MainDB.UseTOTPStep(guid, step); err != nil {
+		if errors.Is(err, database.ErrTOTPReplay) {
+			return false, nil
+		}
+		return false, status.Error(codes.Internal, "failed to update totp step")
+	}
+
+	return true, nil
+}
+
+// rateLimitError has RetryInfo, so client knows when to try again
+func rateLimitError(retry_after time.Duration) error {
+	limit_status := status.New(codes.ResourceExhausted, "too many attempts, try again later")
+	with_details, err := limit_status.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retry_after)})
+	if err != nil {
+		log.Errorf("failed to add error details: %v", err)
+		return limit_status.Err()
+	}
+
+	return with_details.Err()
+}
+
+func newMFAConfig() *mfa_config {
+	ttl, err := strconv.Atoi(utils.GetKeyFromEnv("MFA_CHALLENGE_TTL"))
+	if err != nil || ttl <= 0 {
+		log.Fatalf("invalid MFA_CHALLENGE_TTL: %v", utils.GetKeyFromEnv("MFA_CHALLENGE_TTL"))
+	}
+
+	max_attempts, err := strconv.Atoi(utils.GetKeyFromEnv("MFA_MAX_ATTEMPTS"))
+	if err != nil || max_attempts <= 0 {
+		log.Fatalf("invalid MFA_MAX_ATTEMPTS: %v", utils.GetKeyFromEnv("MFA_MAX_ATTEMPTS"))
+	}
+
+	user_max_failures, err := strconv.Atoi(utils.GetKeyFromEnv("MFA_USER_MAX_FAILURES"))
+	if err != nil || user_max_failures <= 0 {
+		log.Fatalf("invalid MFA_USER_MAX_FAILURES: %v", utils.GetKeyFromEnv("MFA_USER_MAX_FAILURES"))
+	}
+
+	lockout_window, err := strconv.Atoi(utils.GetKeyFromEnv("MFA_LOCKOUT_WINDOW"))
+	if err != nil || lockout_window <= 0 {
+		log.Fatalf("invalid MFA_LOCKOUT_WINDOW: %v", utils.GetKeyFromEnv("MFA_LOCKOUT_WINDOW"))
+	}
+
+	return &mfa_config{
+		ChallengeTTL:    time.Duration(ttl) * time.Minute,
+		MaxAttempts:     max_attempts,
+		UserMaxFailures: user_max_failures,
+		LockoutWindow:   time.Duration(lockout_window) * time.Minute,
+	}
+}
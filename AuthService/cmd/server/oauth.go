@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/source/utils"
+
+	pb "Proto"
+)
+
+// IntrospectToken implements RFC 7662. Only confidential clients may introspect, so tokens can't be
+// checked by anyone who found them.
+func (s *server) IntrospectToken(ctx context.Context, user_request *pb.IntrospectTokenMsg) (*httpbody.HttpBody, error) {
+	setCacheControl(ctx, "no-store")
+
+	client, err := s.authenticateClient(ctx, user_request.ClientId, user_request.ClientSecret)
+	if err != nil {
+		return oauthErrorBody(ctx, err)
+	}
+	if !auth.IsConfidential(client) {
+		return oauthErrorBody(ctx, &auth.OAuthError{Code: auth.InvalidClientError, Description: "only confidential client can introspect tokens"})
+	}
+
+	introspectors := []func(context.Context, string) (*auth.IntrospectionResponse, error){s.introspectAccess, s.introspectRefresh}
+	if user_request.TokenTypeHint == auth.RefreshTokenHint {
+		introspectors[0], introspectors[1] = introspectors[1], introspectors[0]
+	}
+
+	response := &auth.IntrospectionResponse{Active: false}
+	for _, introspect := range introspectors {
+		result, err := introspect(ctx, user_request.Token)
+		if err != nil {
+			return nil, err
+		}
+		if result.Active {
+			response = result
+			break
+		}
+	}
+
+	body, err := jsonBody(response)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to marshal introspection response")
+	}
+	return body, nil
+}
+
+// only errors of our own storages are returned, any problem with token itself means inactive token
+func (s *server) introspectAccess(ctx context.Context, token string) (*auth.IntrospectionResponse, error) {
+	inactive := &auth.IntrospectionResponse{Active: false}
+
+	claims, err := s.AuthManager.VerifyToken("Bearer "+token, true)
+	if err != nil {
+		return inactive, nil
+	}
+
+	is_blacklisted, err := s.BlacklistManager.BlacklistCheck(ctx, claims)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed blacklist check")
+	}
+	if is_blacklisted {
+		return inactive, nil
+	}
+
+	if _, err := s.MainDB.SearchSession(claims.GUID, claims.SessionId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Infof("introspected token of deleted session %v", claims.SessionId)
+			return inactive, nil
+		}
+		return nil, status.Error(codes.Internal, "failed to search session")
+	}
+
+	return auth.NewAccessIntrospection(claims), nil
+}
+
+func (s *server) introspectRefresh(ctx context.Context, token string) (*auth.IntrospectionResponse, error) {
+	session, err := s.MainDB.SearchSessionByRefresh(token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &auth.IntrospectionResponse{Active: false}, nil
+		}
+		return nil, status.Error(codes.Internal, "failed to search session")
+	}
+
+	if !s.RefreshManager.VerifyRefresh(token, session) {
+		return &auth.IntrospectionResponse{Active: false}, nil
+	}
+
+	if err := s.RefreshManager.CheckExpiry(session); err != nil {
+		return &auth.IntrospectionResponse{Active: false}, nil
+	}
+
+	return auth.NewRefreshIntrospection(session, s.RefreshManager.SessionExpiresAt(session)), nil
+}
+
+// RevokeToken implements RFC 7009, so it answers 200 even for unknown or invalid tokens. Client is authenticated
+// like on token endpoint and can revoke only tokens issued to it. First-party tokens have no client, so their
+// user authenticates with own access token instead and can revoke only tokens of own first-party sessions.
+// Access token is blacklisted until it expires, refresh token revocation revokes its whole session.
+func (s *server) RevokeToken(ctx context.Context, user_request *pb.RevokeTokenMsg) (*httpbody.HttpBody, error) {
+	caller, err := s.authenticateRevoker(ctx, user_request)
+	if err != nil {
+		return oauthErrorBody(ctx, err)
+	}
+
+	revokers := []func(context.Context, string, *revoker) (bool, error){s.revokeAccess, s.revokeRefresh}
+	if user_request.TokenTypeHint == auth.RefreshTokenHint {
+		revokers[0], revokers[1] = revokers[1], revokers[0]
+	}
+
+	for _, revoke := range revokers {
+		revoked, err := revoke(ctx, user_request.Token, caller)
+		if err != nil {
+			return oauthErrorBody(ctx, err)
+		}
+		if revoked {
+			break
+		}
+	}
+
+	body, err := jsonBody(struct{}{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to marshal revocation response")
+	}
+	return body, nil
+}
+
+// revoker is authenticated client, or user of first-party session when ClientID is empty
+type revoker struct {
+	ClientID string
+	UserGUID string
+}
+
+// owns reports whether token of given client and user may be revoked by the caller
+func (caller *revoker) owns(client_id string, user_guid string) bool {
+	if caller.ClientID != "" {
+		return client_id == caller.ClientID
+	}
+	return client_id == "" && user_guid == caller.UserGUID
+}
+
+func (caller *revoker) String() string {
+	if caller.ClientID != "" {
+		return "client " + caller.ClientID
+	}
+	return "user " + caller.UserGUID
+}
+
+// authenticateRevoker takes Bearer access token of first-party session when request has no client credentials,
+// otherwise client is authenticated like on token endpoint
+func (s *server) authenticateRevoker(ctx context.Context, user_request *pb.RevokeTokenMsg) (*revoker, error) {
+	authorization, err := utils.GetFromMetadata(ctx, "authorization")
+	if err == nil && strings.HasPrefix(authorization, "Bearer ") && user_request.ClientId == "" && user_request.ClientSecret == "" {
+		claims, err := s.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if claims.ClientID != "" {
+			return nil, &auth.OAuthError{Code: auth.InvalidClientError, Description: "client must authenticate with its credentials"}
+		}
+		return &revoker{UserGUID: claims.GUID}, nil
+	}
+
+	client, err := s.authenticateClient(ctx, user_request.ClientId, user_request.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &revoker{ClientID: client.ID}, nil
+}
+
+// foreignTokenError is returned when caller tries to revoke token which it doesn't own
+func foreignTokenError(caller *revoker, token_client_id string) error {
+	log.Infof("%v tried to revoke token of client %q", caller, token_client_id)
+	if caller.ClientID == "" {
+		return &auth.OAuthError{Code: auth.UnauthorizedClientError, Description: "token doesn't belong to first-party session of the user"}
+	}
+	return &auth.OAuthError{Code: auth.UnauthorizedClientError, Description: "token was issued to another client"}
+}
+
+func (s *server) revokeAccess(ctx context.Context, token string, caller *revoker) (bool, error) {
+	claims, err := s.AuthManager.VerifyToken("Bearer "+token, false)
+	if err != nil {
+		return false, nil
+	}
+	if !caller.owns(claims.ClientID, claims.GUID) {
+		return false, foreignTokenError(caller, claims.ClientID)
+	}
+
+	if err := s.BlacklistManager.AddToBlacklist(ctx, claims); err != nil {
+		return false, status.Error(codes.Internal, "failed to add token to blacklist")
+	}
+
+	log.Infof("access token of session %v revoked by %v", claims.SessionId, caller)
+	return true, nil
+}
+
+func (s *server) revokeRefresh(ctx context.Context, token string, caller *revoker) (bool, error) {
+	session, err := s.MainDB.SearchSessionByRefresh(token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, status.Error(codes.Internal, "failed to search session")
+	}
+
+	if !s.RefreshManager.VerifyRefresh(token, session) {
+		return false, nil
+	}
+	if !caller.owns(session.ClientID, session.UserGUID) {
+		return false, foreignTokenError(caller, session.ClientID)
+	}
+
+	//access tokens of the session are revoked as well, not only the refresh token
+	if err := s.revokeSession(ctx, session); err != nil {
+		return false, err
+	}
+
+	log.Infof("refresh token of session %v revoked by %v", session.ID, caller)
+	return true, nil
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/database"
+	"AuthService/internal/model"
+
+	pb "Proto"
+)
+
+// BeginPasskeyRegistration starts adding passkey to account of logged in user. Returned options are
+// passed to navigator.credentials.create(), ceremony is sent back with its result.
+func (s *server) BeginPasskeyRegistration(ctx context.Context, _ *emptypb.Empty) (*pb.BeginPasskeyReply, error) {
+	claims, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.MainDB.GetUser(claims.GUID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	credentials, err := s.MainDB.ListWebAuthnCredentials(user.GUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list passkeys")
+	}
+
+	options, session, err := s.PasskeyManager.BeginRegistration(user, credentials)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to begin passkey registration")
+	}
+
+	return s.passkeyCeremony(auth.PasskeyRegistrationPurpose, user.GUID, options, session)
+}
+
+func (s *server) FinishPasskeyRegistration(ctx context.Context, user_request *pb.FinishPasskeyRegistrationMsg) (*pb.FinishPasskeyRegistrationReply, error) {
+	claims, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ceremony, err := s.useCeremony(auth.PasskeyRegistrationPurpose, user_request.Ceremony)
+	if err != nil {
+		return nil, err
+	}
+	if ceremony.UserGUID != claims.GUID {
+		return nil, status.Error(codes.InvalidArgument, "passkey ceremony is invalid or expired")
+	}
+
+	user, err := s.MainDB.GetUser(claims.GUID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	credentials, err := s.MainDB.ListWebAuthnCredentials(user.GUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list passkeys")
+	}
+
+	response, err := protojson.Marshal(user_request.Credential)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid credential")
+	}
+	credential, err := s.PasskeyManager.FinishRegistration(user, credentials, ceremony.Data, response)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("passkey registration failed: %v", err))
+	}
+	credential.Name = user_request.Name
+
+	if err := s.MainDB.AddWebAuthnCredential(credential); err != nil {
+		if errors.Is(err, database.ErrPasskeyExists) {
+			return nil, status.Error(codes.AlreadyExists, "passkey is already registered")
+		}
+		return nil, status.Error(codes.Internal, "failed to save passkey")
+	}
+	log.Infof("passkey %v registered for %v", credential.ID, user.GUID)
+
+	return &pb.FinishPasskeyRegistrationReply{Id: uint32(credential.ID)}, nil
+}
+
+// BeginPasskeyLogin starts discoverable login, browser offers passkeys of any account for this site
+func (s *server) BeginPasskeyLogin(ctx context.Context, _ *emptypb.Empty) (*pb.BeginPasskeyReply, error) {
+	options, session, err := s.PasskeyManager.BeginLogin()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to begin passkey login")
+	}
+
+	return s.passkeyCeremony(auth.PasskeyLoginPurpose, "", options, session)
+}
+
+// FinishPasskeyLogin checks assertion and issues token pair like Login. Passkey requires user verification,
+// so it already is two factors and TOTP is not asked.
+func (s *server) FinishPasskeyLogin(ctx context.Context, user_request *pb.FinishPasskeyLoginMsg) (*pb.GetTokensReply, error) {
+	if auth.HasScope(user_request.Scope, auth.OpenIDScope) && user_request.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_id is required for openid scope")
+	}
+
+	user_agent, user_ip, err := clientInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ceremony, err := s.useCeremony(auth.PasskeyLoginPurpose, user_request.Ceremony)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := protojson.Marshal(user_request.Credential)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid credential")
+	}
+	user, credential, err := s.PasskeyManager.FinishLogin(ceremony.Data, response, func(user_handle []byte) (*model.User, []model.WebAuthnCredential, error) {
+		user, err := s.MainDB.GetUser(string(user_handle))
+		if err != nil {
+			return nil, nil, err
+		}
+		credentials, err := s.MainDB.ListWebAuthnCredentials(user.GUID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return user, credentials, nil
+	})
+	if err != nil {
+		log.Infof("failed passkey login from %v: %v", user_ip, err)
+		return nil, status.Error(codes.Unauthenticated, "passkey is not accepted")
+	}
+
+	if err := s.MainDB.UpdateWebAuthnCredential(credential); err != nil {
+		return nil, status.Error(codes.Internal, "failed to update passkey")
+	}
+
+	return s.issueTokens(ctx, user, user_request.Scope, user_request.ClientId, user_request.Nonce, user_agent, user_ip)
+}
+
+// passkeyCeremony keeps WebAuthn session data in single-use token until finish step
+func (s *server) passkeyCeremony(purpose string, guid string, options []byte, session string) (*pb.BeginPasskeyReply, error) {
+	action_token, ceremony, err := s.ActionTokens.GenerateActionToken(purpose, guid, s.PasskeyManager.GetTimeout())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate passkey ceremony")
+	}
+	action_token.Data = session
+	if err := s.MainDB.AddActionToken(action_token); err != nil {
+		return nil, status.Error(codes.Internal, "failed to save passkey ceremony")
+	}
+
+	public_key_options := &structpb.Struct{}
+	if err := protojson.Unmarshal(options, public_key_options); err != nil {
+		log.Errorf("failed to convert passkey options: %v", err)
+		return nil, status.Error(codes.Internal, "failed to convert passkey options")
+	}
+
+	return &pb.BeginPasskeyReply{Ceremony: ceremony, Options: public_key_options}, nil
+}
+
+// useCeremony consumes ceremony before result is checked, failed ceremony has to be started again
+func (s *server) useCeremony(purpose string, token string) (*model.ActionToken, error) {
+	invalid_ceremony := status.Error(codes.InvalidArgument, "passkey ceremony is invalid or expired")
+
+	selector, _, err := auth.SplitActionToken(token)
+	if err != nil {
+		return nil, invalid_ceremony
+	}
+	action_token, err := s.MainDB.SearchActionToken(purpose, selector)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid_ceremony
+		}
+		return nil, status.Error(codes.Internal, "failed to search passkey ceremony")
+	}
+	if !s.ActionTokens.VerifyActionToken(token, action_token) {
+		return nil, invalid_ceremony
+	}
+
+	if err := s.MainDB.UseActionToken(action_token); err != nil {
+		if errors.Is(err, database.ErrActionTokenUsed) {
+			return nil, invalid_ceremony
+		}
+		return nil, status.Error(codes.Internal, "failed to use passkey ceremony")
+	}
+
+	return action_token, nil
+}
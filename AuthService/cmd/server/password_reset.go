@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/database"
+	"AuthService/internal/notify"
+	"AuthService/source/utils"
+
+	pb "Proto"
+)
+
+// password_reset_config Limit is how many reset links can be requested for one email during Window
+type password_reset_config struct {
+	TTL    time.Duration
+	URL    string
+	Limit  int
+	Window time.Duration
+}
+
+// RequestPasswordReset answers the same way whether account exists or not. Token is generated and
+// sent in background, so response time doesn't depend on it either. Requests are limited per email
+// like in StartPasswordlessLogin, so mailbox can't be flooded with links.
+func (s *server) RequestPasswordReset(ctx context.Context, user_request *pb.RequestPasswordResetMsg) (*emptypb.Empty, error) {
+	email, err := auth.NormalizeEmail(user_request.Email)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	allowed, retry_after, err := s.RateLimiter.Allow(ctx, "password_reset:"+utils.HashToken(email), s.PasswordReset.Limit, s.PasswordReset.Window)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check rate limit")
+	}
+	if !allowed {
+		log.Infof("password reset rate limit reached")
+		return nil, rateLimitError(retry_after)
+	}
+
+	go s.sendPasswordReset(context.Background(), email)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) sendPasswordReset(ctx context.Context, email string) {
+	user, err := s.MainDB.SearchUserByIdentifier(email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Infof("password reset requested for unknown email")
+		}
+		return
+	}
+
+	link, err := s.actionLink(auth.PasswordResetPurpose, user.GUID, s.PasswordReset.TTL, s.PasswordReset.URL)
+	if err != nil {
+		return
+	}
+
+	message := &notify.Message{
+		Kind:    auth.PasswordResetPurpose,
+		To:      email,
+		Subject: "Password reset",
+		Body:    fmt.Sprintf("Open the link to set new password. It is valid for %v and can be used once. If you didn't request reset, ignore this message.", s.PasswordReset.TTL),
+		Link:    link,
+	}
+	if err := s.Notifier.Notify(ctx, message); err != nil {
+		log.Errorf("failed to send password reset to %v: %v", user.GUID, err)
+	}
+}
+
+// ConfirmPasswordReset sets new password and logs user out everywhere, old sessions may belong to
+// whoever made the reset necessary
+func (s *server) ConfirmPasswordReset(ctx context.Context, user_request *pb.ConfirmPasswordResetMsg) (*emptypb.Empty, error) {
+	invalid_token := status.Error(codes.InvalidArgument, "reset token is invalid or expired")
+
+	selector, _, err := auth.SplitActionToken(user_request.Token)
+	if err != nil {
+		return nil, invalid_token
+	}
+
+	action_token, err := s.MainDB.SearchActionToken(auth.PasswordResetPurpose, selector)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid_token
+		}
+		return nil, status.Error(codes.Internal, "failed to search reset token")
+	}
+	if !s.ActionTokens.VerifyActionToken(user_request.Token, action_token) {
+		return nil, invalid_token
+	}
+
+	user, err := s.MainDB.GetUser(action_token.UserGUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid_token
+		}
+		return nil, status.Error(codes.Internal, "failed to search user")
+	}
+
+	identifiers := []string{}
+	for _, identifier := range []*string{user.Email, user.Username} {
+		if identifier != nil {
+			identifiers = append(identifiers, *identifier)
+		}
+	}
+	if violations := s.PasswordPolicy.Check(user_request.NewPassword, identifiers...); len(violations) != 0 {
+		return nil, passwordPolicyError(violations)
+	}
+
+	password_hash, err := s.PasswordHasher.HashPassword(user_request.NewPassword)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+
+	sessions, err := s.MainDB.ResetPassword(action_token, password_hash)
+	if err != nil {
+		if errors.Is(err, database.ErrActionTokenUsed) {
+			return nil, invalid_token
+		}
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+
+	access_expiry, err := s.accessExpiry()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.BlacklistManager.RevokeUser(ctx, user.GUID, access_expiry); err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke user tokens")
+	}
+	//user marker spares tokens issued within the current second, deleted sessions are revoked explicitly
+	for _, session := range sessions {
+		if err := s.BlacklistManager.RevokeSession(ctx, session.ID, access_expiry); err != nil {
+			return nil, status.Error(codes.Internal, "failed to revoke session")
+		}
+	}
+	log.Infof("password of %v reset, %v sessions revoked", user.GUID, len(sessions))
+
+	if user.Email != nil {
+		go func(email string) {
+			message := &notify.Message{Kind: "password_changed", To: email, Subject: "Password changed", Body: "Your password was changed and all sessions were closed. If it wasn't you, reset password again."}
+			if err := s.Notifier.Notify(context.Background(), message); err != nil {
+				log.Errorf("failed to notify %v about password change: %v", user.GUID, err)
+			}
+		}(*user.Email)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// actionLink issues single-use token and returns link to base_url with it, older unused tokens
+// of the same purpose stop working
+func (s *server) actionLink(purpose string, guid string, ttl time.Duration, base_url string) (string, error) {
+	action_token, token, err := s.ActionTokens.GenerateActionToken(purpose, guid, ttl)
+	if err != nil {
+		return "", err
+	}
+	if err := s.MainDB.AddActionToken(action_token); err != nil {
+		return "", err
+	}
+
+	return base_url + "?token=" + url.QueryEscape(token), nil
+}
+
+func newPasswordResetConfig() *password_reset_config {
+	ttl, err := strconv.Atoi(utils.GetKeyFromEnv("PASSWORD_RESET_TTL"))
+	if err != nil || ttl <= 0 {
+		log.Fatalf("invalid PASSWORD_RESET_TTL: %v", utils.GetKeyFromEnv("PASSWORD_RESET_TTL"))
+	}
+
+	limit, err := strconv.Atoi(utils.GetKeyFromEnv("PASSWORD_RESET_RATE_LIMIT"))
+	if err != nil || limit <= 0 {
+		log.Fatalf("invalid PASSWORD_RESET_RATE_LIMIT: %v", utils.GetKeyFromEnv("PASSWORD_RESET_RATE_LIMIT"))
+	}
+
+	window, err := strconv.Atoi(utils.GetKeyFromEnv("PASSWORD_RESET_RATE_WINDOW"))
+	if err != nil || window <= 0 {
+		log.Fatalf("invalid PASSWORD_RESET_RATE_WINDOW: %v", utils.GetKeyFromEnv("PASSWORD_RESET_RATE_WINDOW"))
+	}
+
+	return &password_reset_config{
+		TTL:    time.Duration(ttl) * time.Minute,
+		URL:    utils.GetKeyFromEnv("PASSWORD_RESET_URL"),
+		Limit:  limit,
+		Window: time.Duration(window) * time.Minute,
+	}
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	pb "Proto"
+)
+
+func TestRequestPasswordResetRateLimit(t *testing.T) {
+	s, _, _ := testServer(t)
+
+	for attempt := 1; attempt <= s.PasswordReset.Limit; attempt++ {
+		if _, err := s.RequestPasswordReset(requestContext("", "agent"), &pb.RequestPasswordResetMsg{Email: "unknown@example.com"}); err != nil {
+			t.Fatalf("attempt %v: RequestPasswordReset() = %v", attempt, err)
+		}
+	}
+
+	//limit is per normalized email, and it is counted for unknown emails too, so 429 doesn't reveal accounts
+	_, err := s.RequestPasswordReset(requestContext("", "agent"), &pb.RequestPasswordResetMsg{Email: " Unknown@Example.com"})
+	assertCode(t, err, codes.ResourceExhausted)
+
+	if _, err := s.RequestPasswordReset(requestContext("", "agent"), &pb.RequestPasswordResetMsg{Email: "another@example.com"}); err != nil {
+		t.Errorf("another email is limited: %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/database"
+	"AuthService/internal/model"
+	"AuthService/internal/notify"
+	"AuthService/source/utils"
+
+	pb "Proto"
+)
+
+// passwordless_config Limit is how many codes can be requested for one email during Window,
+// code stops working after MaxAttempts wrong codes
+type passwordless_config struct {
+	TTL         time.Duration
+	URL         string
+	Limit       int
+	Window      time.Duration
+	MaxAttempts int
+}
+
+// StartPasswordlessLogin sends one-time code and magic link to email. login_id is returned for any email,
+// the code is generated and sent in background, so response doesn't tell if account exists.
+// New code replaces the previous one.
+func (s *server) StartPasswordlessLogin(ctx context.Context, user_request *pb.StartPasswordlessLoginMsg) (*pb.StartPasswordlessLoginReply, error) {
+	email, err := auth.NormalizeEmail(user_request.Email)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user_agent, user_ip, err := clientInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//email is hashed, so redis doesn't keep addresses
+	allowed, retry_after, err := s.RateLimiter.Allow(ctx, "passwordless:"+utils.HashToken(email), s.Passwordless.Limit, s.Passwordless.Window)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check rate limit")
+	}
+	if !allowed {
+		log.Infof("passwordless login rate limit reached from %v", user_ip)
+		return nil, rateLimitError(retry_after)
+	}
+
+	action_token, code, err := s.ActionTokens.GenerateActionCode(auth.PasswordlessLoginPurpose, "", s.Passwordless.TTL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate login code")
+	}
+	action_token.Data = user_agent
+
+	go s.sendPasswordlessCode(context.Background(), email, action_token, code)
+
+	return &pb.StartPasswordlessLoginReply{LoginId: action_token.Selector}, nil
+}
+
+func (s *server) sendPasswordlessCode(ctx context.Context, email string, action_token *model.ActionToken, code string) {
+	user, err := s.MainDB.SearchUserByIdentifier(email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Infof("passwordless login requested for unknown email")
+		}
+		return
+	}
+
+	action_token.UserGUID = user.GUID
+	if err := s.MainDB.AddActionToken(action_token); err != nil {
+		return
+	}
+
+	login_id, one_time_code, _ := auth.SplitActionToken(code)
+	message := &notify.Message{
+		Kind:    auth.PasswordlessLoginPurpose,
+		To:      email,
+		Subject: "Your login code",
+		Body:    fmt.Sprintf("Your login code is %v. Enter it or open the link in the same browser. It is valid for %v and can be used once. If you didn't try to log in, ignore this message.", one_time_code, s.Passwordless.TTL),
+		Link:    s.Passwordless.URL + "?login_id=" + url.QueryEscape(login_id) + "&code=" + url.QueryEscape(one_time_code),
+	}
+	if err := s.Notifier.Notify(ctx, message); err != nil {
+		log.Errorf("failed to send login code to %v: %v", user.GUID, err)
+	}
+}
+
+// CompletePasswordlessLogin exchanges code for tokens. It works only from user agent which started login,
+// wrong codes are counted up to PASSWORDLESS_MAX_ATTEMPTS. Code proves access to mailbox, so email becomes verified.
+func (s *server) CompletePasswordlessLogin(ctx context.Context, user_request *pb.CompletePasswordlessLoginMsg) (*pb.GetTokensReply, error) {
+	if auth.HasScope(user_request.Scope, auth.OpenIDScope) && user_request.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_id is required for openid scope")
+	}
+
+	user_agent, user_ip, err := clientInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid_code := status.Error(codes.Unauthenticated, "login code is invalid or expired")
+	action_token, err := s.MainDB.SearchActionToken(auth.PasswordlessLoginPurpose, user_request.LoginId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid_code
+		}
+		return nil, status.Error(codes.Internal, "failed to search login code")
+	}
+
+	if action_token.Data != user_agent || !s.ActionTokens.VerifyActionToken(user_request.LoginId+"."+user_request.Code, action_token) {
+		log.Infof("wrong passwordless login code for %v from %v", action_token.UserGUID, user_ip)
+		if err := s.MainDB.FailActionToken(action_token, s.Passwordless.MaxAttempts); err != nil {
+			return nil, status.Error(codes.Internal, "failed to count login attempt")
+		}
+		return nil, invalid_code
+	}
+
+	if err := s.MainDB.UsePasswordlessCode(action_token); err != nil {
+		if errors.Is(err, database.ErrActionTokenUsed) {
+			return nil, invalid_code
+		}
+		return nil, status.Error(codes.Internal, "failed to use login code")
+	}
+
+	user, err := s.MainDB.GetUser(action_token.UserGUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to search user")
+	}
+
+	challenge, err := s.mfaChallenge(user)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != "" {
+		return &pb.GetTokensReply{MfaChallenge: challenge}, nil
+	}
+
+	return s.issueTokens(ctx, user, user_request.Scope, user_request.ClientId, user_request.Nonce, user_agent, user_ip)
+}
+
+func newPasswordlessConfig() *passwordless_config {
+	ttl, err := strconv.Atoi(utils.GetKeyFromEnv("PASSWORDLESS_TTL"))
+	if err != nil || ttl <= 0 {
+		log.Fatalf("invalid PASSWORDLESS_TTL: %v", utils.GetKeyFromEnv("PASSWORDLESS_TTL"))
+	}
+
+	limit, err := strconv.Atoi(utils.GetKeyFromEnv("PASSWORDLESS_RATE_LIMIT"))
+	if err != nil || limit <= 0 {
+		log.Fatalf("invalid PASSWORDLESS_RATE_LIMIT: %v", utils.GetKeyFromEnv("PASSWORDLESS_RATE_LIMIT"))
+	}
+
+	window, err := strconv.Atoi(utils.GetKeyFromEnv("PASSWORDLESS_RATE_WINDOW"))
+	if err != nil || window <= 0 {
+		log.Fatalf("invalid PASSWORDLESS_RATE_WINDOW: %v", utils.GetKeyFromEnv("PASSWORDLESS_RATE_WINDOW"))
+	}
+
+	max_attempts, err := strconv.Atoi(utils.GetKeyFromEnv("PASSWORDLESS_MAX_ATTEMPTS"))
+	if err != nil || max_attempts <= 0 {
+		log.Fatalf("invalid PASSWORDLESS_MAX_ATTEMPTS: %v", utils.GetKeyFromEnv("PASSWORDLESS_MAX_ATTEMPTS"))
+	}
+
+	return &passwordless_config{
+		TTL:         time.Duration(ttl) * time.Minute,
+		URL:         utils.GetKeyFromEnv("PASSWORDLESS_URL"),
+		Limit:       limit,
+		Window:      time.Duration(window) * time.Minute,
+		MaxAttempts: max_attempts,
+	}
+}
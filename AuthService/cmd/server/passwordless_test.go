@@ -0,0 +1,71 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/model"
+
+	pb "Proto"
+)
+
+// startPasswordless sends login code synchronously and returns login_id and code from the link
+func startPasswordless(t *testing.T, s *server, db *fakeDB, user_agent string) (string, string) {
+	t.Helper()
+	email := "user@example.com"
+	db.users["user-guid"] = &model.User{GUID: "user-guid", Email: &email}
+
+	action_token, code, err := s.ActionTokens.GenerateActionCode(auth.PasswordlessLoginPurpose, "", s.Passwordless.TTL)
+	if err != nil {
+		t.Fatalf("GenerateActionCode() = %v", err)
+	}
+	action_token.Data = user_agent
+	s.sendPasswordlessCode(requestContext("", user_agent), email, action_token, code)
+
+	notifier := s.Notifier.(*fakeNotifier)
+	return notifier.lastLinkParam(t, "login_id"), notifier.lastLinkParam(t, "code")
+}
+
+func TestCompletePasswordlessLogin(t *testing.T) {
+	s, db, _ := testServer(t)
+	login_id, code := startPasswordless(t, s, db, "agent")
+
+	//code works only from user agent which started login
+	_, err := s.CompletePasswordlessLogin(requestContext("", "other agent"), &pb.CompletePasswordlessLoginMsg{LoginId: login_id, Code: code})
+	assertCode(t, err, codes.Unauthenticated)
+
+	reply, err := s.CompletePasswordlessLogin(requestContext("", "agent"), &pb.CompletePasswordlessLoginMsg{LoginId: login_id, Code: code})
+	if err != nil {
+		t.Fatalf("CompletePasswordlessLogin() = %v", err)
+	}
+	if reply.Access == "" || reply.Refresh == "" {
+		t.Errorf("CompletePasswordlessLogin() = %v, want token pair", reply)
+	}
+	if !db.users["user-guid"].EmailVerified {
+		t.Errorf("email is not verified by login code")
+	}
+
+	//code is accepted once
+	_, err = s.CompletePasswordlessLogin(requestContext("", "agent"), &pb.CompletePasswordlessLoginMsg{LoginId: login_id, Code: code})
+	assertCode(t, err, codes.Unauthenticated)
+}
+
+func TestCompletePasswordlessLoginMaxAttempts(t *testing.T) {
+	s, db, _ := testServer(t)
+	s.MFA = &mfa_config{MaxAttempts: 100}
+	login_id, code := startPasswordless(t, s, db, "agent")
+
+	//PASSWORDLESS_MAX_ATTEMPTS burns the code, not MFA_MAX_ATTEMPTS
+	for attempt := 1; attempt <= s.Passwordless.MaxAttempts; attempt++ {
+		_, err := s.CompletePasswordlessLogin(requestContext("", "agent"), &pb.CompletePasswordlessLoginMsg{LoginId: login_id, Code: "000000"})
+		assertCode(t, err, codes.Unauthenticated)
+	}
+
+	_, err := s.CompletePasswordlessLogin(requestContext("", "agent"), &pb.CompletePasswordlessLoginMsg{LoginId: login_id, Code: code})
+	assertCode(t, err, codes.Unauthenticated)
+	if db.users["user-guid"].EmailVerified {
+		t.Errorf("email is verified by burned code")
+	}
+}
@@ -0,0 +1,529 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/database"
+	"AuthService/internal/model"
+	"AuthService/internal/notify"
+	"AuthService/source/utils"
+)
+
+// fakeDB keeps users and sessions in memory. Methods which tests don't need are left to the embedded
+// nil Database, so test fails with panic when handler starts to use something new.
+type fakeDB struct {
+	database.Database
+	mu        sync.Mutex
+	user_lock sync.Mutex
+	users     map[string]*model.User
+	sessions  map[uint]*model.Session
+	used      map[string]*model.UsedRefreshToken
+	actions   map[uint]*model.ActionToken
+	clients   map[string]*model.OAuthClient
+	next_id   uint
+	//before_rotate is called by RotateSession before the session is locked, so tests can race with it
+	before_rotate func()
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{users: map[string]*model.User{}, sessions: map[uint]*model.Session{}, used: map[string]*model.UsedRefreshToken{}, actions: map[uint]*model.ActionToken{}, clients: map[string]*model.OAuthClient{}}
+}
+
+func (db *fakeDB) GetUser(guid string) (*model.User, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	user, ok := db.users[guid]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (db *fakeDB) SearchUserByIdentifier(identifier string) (*model.User, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, user := range db.users {
+		if (user.Email != nil && *user.Email == identifier) || (user.Username != nil && *user.Username == identifier) {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (db *fakeDB) AddSession(guid string, client_id string, scope string, refresh_generator auth.RefreshManager, life_time time.Duration, user_agent string, user_ip string) (uint, string, error) {
+	now := time.Now()
+	session := &model.Session{
+		UserGUID:   guid,
+		CreatedAt:  now.Unix(),
+		LastUsedAt: now.Unix(),
+		ExpiresAt:  now.Add(life_time).Unix(),
+		UserIP:     user_ip,
+		UserAgent:  user_agent,
+		ClientID:   client_id,
+		Scope:      scope,
+	}
+	refresh, err := fakeRefresh(session, refresh_generator)
+	if err != nil {
+		return 0, "", err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.next_id++
+	session.ID = db.next_id
+	session.FamilyID = fmt.Sprintf("family-%v", session.ID)
+	db.sessions[session.ID] = session
+	return session.ID, refresh, nil
+}
+
+func fakeRefresh(session *model.Session, refresh_generator auth.RefreshManager) (string, error) {
+	refresh, err := refresh_generator.GenerateRefreshToken()
+	if err != nil {
+		return "", err
+	}
+	session.Selector = &refresh.Selector
+	session.Refresh = refresh_generator.HashVerifier(refresh.Verifier)
+	session.RefreshDigest = utils.HashToken(refresh.String())
+	return refresh.String(), nil
+}
+
+func (db *fakeDB) SearchSession(guid string, session_id uint) (*model.Session, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	session, ok := db.sessions[session_id]
+	if !ok || session.UserGUID != guid {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *session
+	return &copied, nil
+}
+
+func (db *fakeDB) SearchSessionByRefresh(refresh string) (*model.Session, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, session := range db.sessions {
+		if session.RefreshDigest == utils.HashToken(refresh) {
+			copied := *session
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (db *fakeDB) ListSessions(guid string) ([]model.Session, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	sessions := []model.Session{}
+	for _, session := range db.sessions {
+		if session.UserGUID == guid && session.ExpiresAt > time.Now().Unix() {
+			sessions = append(sessions, *session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].LastUsedAt > sessions[j].LastUsedAt })
+	return sessions, nil
+}
+
+func (db *fakeDB) RotateSession(old *model.Session, refresh string, refresh_generator auth.RefreshManager, life_time time.Duration, user_agent string, user_ip string) (uint, string, error) {
+	if before_rotate := db.before_rotate; before_rotate != nil {
+		db.before_rotate = nil
+		before_rotate()
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	locked, ok := db.sessions[old.ID]
+	if !ok || locked.UserGUID != old.UserGUID {
+		return 0, "", gorm.ErrRecordNotFound
+	}
+	if !refresh_generator.VerifyRefresh(refresh, locked) {
+		return 0, "", database.ErrRefreshMismatch
+	}
+
+	now := time.Now()
+	child := *locked
+	child.ParentID = locked.ID
+	child.LastUsedAt = now.Unix()
+	if expires_at := now.Add(life_time).Unix(); expires_at < child.ExpiresAt {
+		child.ExpiresAt = expires_at
+	}
+	child.UserAgent, child.UserIP = user_agent, user_ip
+	new_refresh, err := fakeRefresh(&child, refresh_generator)
+	if err != nil {
+		return 0, "", err
+	}
+
+	db.used[utils.HashToken(refresh)] = &model.UsedRefreshToken{RefreshDigest: utils.HashToken(refresh), FamilyID: locked.FamilyID, UserGUID: locked.UserGUID, SessionID: locked.ID, ExpiresAt: locked.ExpiresAt}
+	delete(db.sessions, locked.ID)
+	db.next_id++
+	child.ID = db.next_id
+	db.sessions[child.ID] = &child
+	return child.ID, new_refresh, nil
+}
+
+func (db *fakeDB) SearchUsedRefresh(refresh string) (*model.UsedRefreshToken, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	used, ok := db.used[utils.HashToken(refresh)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return used, nil
+}
+
+func (db *fakeDB) DeleteSessionFamily(family_id string) ([]model.Session, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	deleted := []model.Session{}
+	for id, session := range db.sessions {
+		if session.FamilyID == family_id {
+			deleted = append(deleted, *session)
+			delete(db.sessions, id)
+		}
+	}
+	return deleted, nil
+}
+
+func (db *fakeDB) DeleteSession(guid string, session_id uint) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if session, ok := db.sessions[session_id]; ok && session.UserGUID == guid {
+		delete(db.sessions, session_id)
+	}
+	return nil
+}
+
+// WithUserLock has one lock for all users, it is enough for tests
+func (db *fakeDB) WithUserLock(guid string, fn func() error) error {
+	db.user_lock.Lock()
+	defer db.user_lock.Unlock()
+	return fn()
+}
+
+// AddActionToken replaces unused tokens of the same user and purpose like postgres does
+func (db *fakeDB) AddActionToken(action_token *model.ActionToken) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for id, stored := range db.actions {
+		if action_token.UserGUID != "" && action_token.Purpose != auth.OAuthCodePurpose && stored.UserGUID == action_token.UserGUID && stored.Purpose == action_token.Purpose && stored.UsedAt == 0 {
+			delete(db.actions, id)
+		}
+	}
+	db.next_id++
+	action_token.ID = db.next_id
+	copied := *action_token
+	db.actions[copied.ID] = &copied
+	return nil
+}
+
+func (db *fakeDB) SearchActionToken(purpose string, selector string) (*model.ActionToken, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, stored := range db.actions {
+		if stored.Purpose == purpose && stored.Selector == selector {
+			copied := *stored
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// useActionToken must be called with db.mu locked
+func (db *fakeDB) useActionToken(action_token *model.ActionToken) error {
+	stored, ok := db.actions[action_token.ID]
+	if !ok || stored.UsedAt != 0 {
+		return database.ErrActionTokenUsed
+	}
+	stored.UsedAt = time.Now().Unix()
+	return nil
+}
+
+func (db *fakeDB) UseActionToken(action_token *model.ActionToken) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.useActionToken(action_token)
+}
+
+func (db *fakeDB) VerifyEmail(action_token *model.ActionToken) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if err := db.useActionToken(action_token); err != nil {
+		return err
+	}
+	db.users[action_token.UserGUID].EmailVerified = true
+	return nil
+}
+
+func (db *fakeDB) UsePasswordlessCode(action_token *model.ActionToken) error {
+	if action_token.Purpose != auth.PasswordlessLoginPurpose {
+		return database.ErrActionTokenUsed
+	}
+	return db.VerifyEmail(action_token)
+}
+
+func (db *fakeDB) FailActionToken(action_token *model.ActionToken, max_attempts int) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	stored, ok := db.actions[action_token.ID]
+	if !ok || stored.UsedAt != 0 {
+		return nil
+	}
+	stored.Attempts++
+	if stored.Attempts >= max_attempts {
+		stored.UsedAt = time.Now().Unix()
+	}
+	return nil
+}
+
+// GetTOTP finds nothing, so users of tests don't have second factor
+func (db *fakeDB) GetTOTP(guid string) (*model.TOTPCredential, error) {
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (db *fakeDB) GetClient(client_id string) (*model.OAuthClient, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	client, ok := db.clients[client_id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *client
+	return &copied, nil
+}
+
+func (db *fakeDB) AddClient(client *model.OAuthClient) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if _, ok := db.clients[client.ID]; ok {
+		return database.ErrClientExists
+	}
+	client.CreatedAt = time.Now().Unix()
+	copied := *client
+	db.clients[client.ID] = &copied
+	return nil
+}
+
+// UpdateClient keeps secret and disabled flag like postgres does
+func (db *fakeDB) UpdateClient(client *model.OAuthClient) error {
+	return db.updateClient(client.ID, func(stored *model.OAuthClient) {
+		stored.GrantTypes, stored.RedirectURIs, stored.Scopes = client.GrantTypes, client.RedirectURIs, client.Scopes
+		stored.AccessLifeTime, stored.RefreshLifeTime = client.AccessLifeTime, client.RefreshLifeTime
+	})
+}
+
+func (db *fakeDB) UpdateClientSecret(client_id string, secret_hash string) error {
+	return db.updateClient(client_id, func(stored *model.OAuthClient) { stored.SecretHash = secret_hash })
+}
+
+func (db *fakeDB) DisableClient(client_id string) error {
+	return db.updateClient(client_id, func(stored *model.OAuthClient) { stored.Disabled = true })
+}
+
+func (db *fakeDB) updateClient(client_id string, update func(stored *model.OAuthClient)) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	stored, ok := db.clients[client_id]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	update(stored)
+	return nil
+}
+
+func (db *fakeDB) MaxAccessLifeTime() (time.Duration, error) {
+	return 0, nil
+}
+
+// fakeBlacklist has the same markers as redis blacklist, without their expiry and the wait of RevokeUser
+type fakeBlacklist struct {
+	mu       sync.Mutex
+	tokens   map[string]bool
+	sessions map[uint]bool
+	users    map[string]int64
+}
+
+func newFakeBlacklist() *fakeBlacklist {
+	return &fakeBlacklist{tokens: map[string]bool{}, sessions: map[uint]bool{}, users: map[string]int64{}}
+}
+
+func (blacklist *fakeBlacklist) AddToBlacklist(ctx context.Context, claims *auth.TokenClaims) error {
+	blacklist.mu.Lock()
+	defer blacklist.mu.Unlock()
+	blacklist.tokens[claims.Id] = true
+	return nil
+}
+
+func (blacklist *fakeBlacklist) RevokeSession(ctx context.Context, session_id uint, expiry int64) error {
+	blacklist.mu.Lock()
+	defer blacklist.mu.Unlock()
+	blacklist.sessions[session_id] = true
+	return nil
+}
+
+func (blacklist *fakeBlacklist) RevokeUser(ctx context.Context, guid string, expiry int64) error {
+	blacklist.mu.Lock()
+	defer blacklist.mu.Unlock()
+	blacklist.users[guid] = time.Now().Unix()
+	return nil
+}
+
+func (blacklist *fakeBlacklist) BlacklistCheck(ctx context.Context, claims *auth.TokenClaims) (bool, error) {
+	blacklist.mu.Lock()
+	defer blacklist.mu.Unlock()
+	if blacklist.tokens[claims.Id] || blacklist.sessions[claims.SessionId] {
+		return true, nil
+	}
+	revoked_at, ok := blacklist.users[claims.GUID]
+	return ok && claims.IssuedAt <= revoked_at, nil
+}
+
+// fakeRateLimiter counts attempts of each key, window never ends
+type fakeRateLimiter struct {
+	mu       sync.Mutex
+	attempts map[string]int
+}
+
+func (limiter *fakeRateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	limiter.attempts[key]++
+	if limiter.attempts[key] > limit {
+		return false, window, nil
+	}
+	return true, 0, nil
+}
+
+func (limiter *fakeRateLimiter) Limited(ctx context.Context, key string, limit int) (bool, time.Duration, error) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	return limiter.attempts[key] >= limit, time.Minute, nil
+}
+
+// fakeNotifier collects messages instead of sending them
+type fakeNotifier struct {
+	mu       sync.Mutex
+	messages []*notify.Message
+}
+
+func (notifier *fakeNotifier) Notify(ctx context.Context, message *notify.Message) error {
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+	notifier.messages = append(notifier.messages, message)
+	return nil
+}
+
+// lastLinkParam returns query parameter of link in the last message
+func (notifier *fakeNotifier) lastLinkParam(t *testing.T, name string) string {
+	t.Helper()
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+	if len(notifier.messages) == 0 {
+		t.Fatalf("nothing is sent")
+	}
+	link, err := url.Parse(notifier.messages[len(notifier.messages)-1].Link)
+	if err != nil {
+		t.Fatalf("invalid link: %v", err)
+	}
+	return link.Query().Get(name)
+}
+
+// testServer has in-memory storages and HS256 tokens, limits and optional features are off
+func testServer(t *testing.T) (*server, *fakeDB, *fakeBlacklist) {
+	t.Helper()
+	key, err := auth.NewHMACKey(jwt.SigningMethodHS256, "secret key of at least 32 bytes long")
+	if err != nil {
+		t.Fatalf("NewHMACKey() = %v", err)
+	}
+
+	db := newFakeDB()
+	blacklist := newFakeBlacklist()
+	s := &server{
+		MainDB:           db,
+		AuthManager:      &auth.JWTManager{Keys: auth.NewKeyRing(key, time.Hour), Issuer: "http://localhost:8080", Audiences: []string{"api"}, TokenDuration: time.Minute},
+		RefreshManager:   auth.NewRefreshGenerator(32, 24*time.Hour, time.Hour, "pepper"),
+		BlacklistManager: blacklist,
+		SessionLimit:     &auth.SessionLimit{},
+		ActionTokens:     auth.NewActionTokenManager("pepper"),
+		Notifier:         &fakeNotifier{},
+		RateLimiter:      &fakeRateLimiter{attempts: map[string]int{}},
+		PasswordReset:    &password_reset_config{TTL: time.Hour, URL: "http://localhost:3000/reset-password", Limit: 2, Window: 15 * time.Minute},
+		Verification:     &email_verification_config{TTL: time.Hour, URL: "http://localhost:3000/verify-email", Policy: allowUnverifiedPolicy, Limit: 2, Window: 15 * time.Minute},
+		ClientSecrets:    auth.NewClientSecretManager("pepper"),
+		OAuth:            &oauth_config{CodeTTL: time.Minute, LoginURL: "http://localhost:3000/login"},
+		Passwordless:     &passwordless_config{TTL: time.Hour, URL: "http://localhost:8082/passwordless", Limit: 2, Window: 15 * time.Minute, MaxAttempts: 2},
+	}
+	return s, db, blacklist
+}
+
+// login creates first-party session of user like Login does and returns its access and refresh tokens
+func login(t *testing.T, s *server, db *fakeDB, guid string, user_agent string) (uint, string, string) {
+	t.Helper()
+	db.mu.Lock()
+	if _, ok := db.users[guid]; !ok {
+		db.users[guid] = &model.User{GUID: guid}
+	}
+	db.mu.Unlock()
+
+	session_id, refresh, err := db.AddSession(guid, "", "", s.RefreshManager, 24*time.Hour, user_agent, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("AddSession() = %v", err)
+	}
+	user, _ := db.GetUser(guid)
+	access, err := s.AuthManager.GenerateToken(user, session_id, "", "", s.accessLifeTime(nil))
+	if err != nil {
+		t.Fatalf("GenerateToken() = %v", err)
+	}
+	return session_id, access, refresh
+}
+
+// testWebhook answers WEBHOOK_URL and collects messages sent to it
+func testWebhook(t *testing.T) *[]map[string]string {
+	t.Helper()
+	messages := &[]map[string]string{}
+	mu := sync.Mutex{}
+	webhook := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		message := map[string]string{}
+		if err := json.NewDecoder(request.Body).Decode(&message); err != nil {
+			t.Errorf("webhook got invalid json: %v", err)
+		}
+		mu.Lock()
+		*messages = append(*messages, message)
+		mu.Unlock()
+	}))
+	t.Cleanup(webhook.Close)
+	t.Setenv("WEBHOOK_URL", webhook.URL)
+	return messages
+}
+
+// requestContext has headers which gateway passes to handlers, access token may be empty
+func requestContext(access string, user_agent string) context.Context {
+	md := metadata.Pairs("x-user-agent", user_agent, "x-forwarded-for", "127.0.0.1")
+	if access != "" {
+		md.Set("authorization", "Bearer "+access)
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func assertCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if status.Code(err) != code {
+		t.Fatalf("error = %v, want %v", err, code)
+	}
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/model"
+	"AuthService/source/utils"
+
+	pb "Proto"
+)
+
+// ListSessions shows devices of user. Sessions which are expired by idle time are still in db
+// until next refresh attempt, so they are filtered here.
+func (s *server) ListSessions(ctx context.Context, _ *emptypb.Empty) (*pb.ListSessionsReply, error) {
+	claims, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.MainDB.ListSessions(claims.GUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list sessions")
+	}
+
+	reply := &pb.ListSessionsReply{Sessions: []*pb.SessionInfo{}}
+	for _, session := range sessions {
+		if err := s.RefreshManager.CheckExpiry(&session); err != nil {
+			continue
+		}
+
+		user_agent := utils.ParseUserAgent(session.UserAgent)
+		reply.Sessions = append(reply.Sessions, &pb.SessionInfo{
+			Id:         uint64(session.ID),
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  s.RefreshManager.SessionExpiresAt(&session),
+			Ip:         session.UserIP,
+			UserAgent:  session.UserAgent,
+			Browser:    user_agent.Browser,
+			Os:         user_agent.OS,
+			Device:     user_agent.Device,
+			Current:    session.ID == claims.SessionId,
+		})
+	}
+
+	return reply, nil
+}
+
+func (s *server) RevokeSession(ctx context.Context, user_request *pb.RevokeSessionMsg) (*emptypb.Empty, error) {
+	claims, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.MainDB.SearchSession(claims.GUID, uint(user_request.SessionId))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to search session")
+	}
+
+	if err := s.revokeSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) RevokeAllSessions(ctx context.Context, user_request *pb.RevokeAllSessionsMsg) (*pb.RevokeAllSessionsReply, error) {
+	claims, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.MainDB.ListSessions(claims.GUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list sessions")
+	}
+
+	//without exception every token of user is revoked, even of sessions which are already expired and not listed
+	if !user_request.ExceptCurrent {
+		access_expiry, err := s.accessExpiry()
+		if err != nil {
+			return nil, err
+		}
+		if err := s.BlacklistManager.RevokeUser(ctx, claims.GUID, access_expiry); err != nil {
+			return nil, status.Error(codes.Internal, "failed to revoke user tokens")
+		}
+	}
+
+	reply := &pb.RevokeAllSessionsReply{}
+	for _, session := range sessions {
+		if user_request.ExceptCurrent && session.ID == claims.SessionId {
+			continue
+		}
+
+		if err := s.revokeSession(ctx, &session); err != nil {
+			return nil, err
+		}
+		reply.Revoked++
+	}
+
+	log.Infof("%v sessions of %v revoked", reply.Revoked, claims.GUID)
+	return reply, nil
+}
+
+// revokeSession deletes session and blacklists it in redis, so access tokens issued for it
+// are rejected too, not only the refresh token
+func (s *server) revokeSession(ctx context.Context, session *model.Session) error {
+	if err := s.MainDB.DeleteSession(session.UserGUID, session.ID); err != nil {
+		return status.Error(codes.Internal, "failed to delete session")
+	}
+
+	access_expiry, err := s.accessExpiry()
+	if err != nil {
+		return err
+	}
+	if err := s.BlacklistManager.RevokeSession(ctx, session.ID, access_expiry); err != nil {
+		return status.Error(codes.Internal, "failed to revoke session")
+	}
+
+	return nil
+}
+
+// accessExpiry is time when every access token issued by now is expired, revocation markers must live until then.
+// Clients can have longer access lifetime than ACCESS_LIFE_TIME, so the longest one is taken.
+func (s *server) accessExpiry() (int64, error) {
+	life_time := s.AuthManager.GetTokenDuration()
+	client_life_time, err := s.MainDB.MaxAccessLifeTime()
+	if err != nil {
+		return 0, status.Error(codes.Internal, "failed to find access token life time")
+	}
+	if client_life_time > life_time {
+		life_time = client_life_time
+	}
+
+	return time.Now().Add(life_time).Unix(), nil
+}
+
+// addSessionWithinLimit creates session after session limit is applied. Limit check and insert are done
+// under advisory lock of user, so parallel logins can't exceed the limit.
+func (s *server) addSessionWithinLimit(ctx context.Context, guid string, client_id string, scope string, life_time time.Duration, user_agent string, user_ip string) (uint, string, error) {
+	add_session := func() (uint, string, error) {
+		session_id, refresh, err := s.MainDB.AddSession(guid, client_id, scope, s.RefreshManager, life_time, user_agent, user_ip)
+		if err != nil {
+			return 0, "", status.Error(codes.Internal, "failed to add session in db")
+		}
+		return session_id, refresh, nil
+	}
+	if s.SessionLimit.MaxSessions == 0 {
+		return add_session()
+	}
+
+	var session_id uint
+	var refresh string
+	err := s.MainDB.WithUserLock(guid, func() (err error) {
+		if err = s.makeRoomForSession(ctx, guid); err != nil {
+			return err
+		}
+		session_id, refresh, err = add_session()
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			return 0, "", status.Error(codes.Internal, "failed to lock sessions of user")
+		}
+		return 0, "", err
+	}
+
+	return session_id, refresh, nil
+}
+
+// makeRoomForSession applies session limit before new session of user is created
+func (s *server) makeRoomForSession(ctx context.Context, guid string) error {
+	sessions, err := s.MainDB.ListSessions(guid)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to list sessions")
+	}
+
+	//sessions are ordered from the most recently used, so the tail is evicted first
+	active := []model.Session{}
+	for _, session := range sessions {
+		if s.RefreshManager.CheckExpiry(&session) == nil {
+			active = append(active, session)
+		}
+	}
+
+	excess := s.SessionLimit.Excess(len(active))
+	if excess == 0 {
+		return nil
+	}
+	if s.SessionLimit.Policy == auth.RejectPolicy {
+		return status.Error(codes.ResourceExhausted, "session limit reached, logout from another device")
+	}
+
+	for _, session := range active[len(active)-excess:] {
+		if err := s.revokeSession(ctx, &session); err != nil {
+			return err
+		}
+		log.Infof("session %v of %v evicted by session limit", session.ID, guid)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/model"
+
+	pb "Proto"
+)
+
+func TestListSessions(t *testing.T) {
+	s, db, _ := testServer(t)
+	const chrome = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+	const iphone = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"
+
+	desktop_id, desktop_access, _ := login(t, s, db, "user-guid", chrome)
+	phone_id, _, _ := login(t, s, db, "user-guid", iphone)
+	idle_id, _, _ := login(t, s, db, "user-guid", chrome)
+	login(t, s, db, "other-guid", chrome)
+
+	//desktop was used earlier than phone, idle session is not refreshable anymore
+	db.sessions[desktop_id].LastUsedAt -= 60
+	db.sessions[idle_id].LastUsedAt -= int64(2 * time.Hour / time.Second)
+
+	reply, err := s.ListSessions(requestContext(desktop_access, chrome), &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("ListSessions() = %v", err)
+	}
+	if len(reply.Sessions) != 2 || reply.Sessions[0].Id != uint64(phone_id) || reply.Sessions[1].Id != uint64(desktop_id) {
+		t.Fatalf("ListSessions() = %v, want phone and desktop sessions of the user", reply.Sessions)
+	}
+
+	phone, desktop := reply.Sessions[0], reply.Sessions[1]
+	//session of access token from the request is the current one
+	if phone.Current || !desktop.Current {
+		t.Errorf("current flags: phone %v, desktop %v", phone.Current, desktop.Current)
+	}
+	if desktop.Browser != "Chrome 120" || desktop.Os != "Windows" || phone.Device != "Mobile" {
+		t.Errorf("desktop %v on %v, phone device %v", desktop.Browser, desktop.Os, phone.Device)
+	}
+	if desktop.ExpiresAt != db.sessions[desktop_id].LastUsedAt+3600 || desktop.Ip != "127.0.0.1" {
+		t.Errorf("desktop expires at %v, ip %v", desktop.ExpiresAt, desktop.Ip)
+	}
+}
+
+func TestListSessionsRevokedToken(t *testing.T) {
+	s, db, blacklist := testServer(t)
+	session_id, access, _ := login(t, s, db, "user-guid", "agent")
+	blacklist.sessions[session_id] = true
+
+	_, err := s.ListSessions(requestContext(access, "agent"), &emptypb.Empty{})
+	assertCode(t, err, codes.Unauthenticated)
+}
+
+func TestRevokeSession(t *testing.T) {
+	s, db, _ := testServer(t)
+	current_id, current_access, _ := login(t, s, db, "user-guid", "agent")
+	other_id, other_access, _ := login(t, s, db, "user-guid", "agent")
+	_, foreign_access, _ := login(t, s, db, "other-guid", "agent")
+
+	//session of another user is not found
+	_, err := s.RevokeSession(requestContext(foreign_access, "agent"), &pb.RevokeSessionMsg{SessionId: uint64(other_id)})
+	assertCode(t, err, codes.NotFound)
+
+	if _, err := s.RevokeSession(requestContext(current_access, "agent"), &pb.RevokeSessionMsg{SessionId: uint64(other_id)}); err != nil {
+		t.Fatalf("RevokeSession() = %v", err)
+	}
+	if _, err := db.SearchSession("user-guid", other_id); err == nil {
+		t.Errorf("revoked session %v is not deleted", other_id)
+	}
+
+	//access token of revoked session is rejected right away, not after its expiry
+	_, err = s.GetGUID(requestContext(other_access, "agent"), &emptypb.Empty{})
+	assertCode(t, err, codes.Unauthenticated)
+	if _, err := s.GetGUID(requestContext(current_access, "agent"), &emptypb.Empty{}); err != nil {
+		t.Errorf("token of session %v is rejected: %v", current_id, err)
+	}
+}
+
+func TestRevokeAllSessions(t *testing.T) {
+	tests := []struct {
+		name           string
+		except_current bool
+		revoked        uint32
+	}{
+		{"all sessions", false, 3},
+		{"except current", true, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, db, blacklist := testServer(t)
+			_, current_access, _ := login(t, s, db, "user-guid", "agent")
+			_, first_access, _ := login(t, s, db, "user-guid", "agent")
+			_, second_access, _ := login(t, s, db, "user-guid", "agent")
+			_, foreign_access, _ := login(t, s, db, "other-guid", "agent")
+
+			reply, err := s.RevokeAllSessions(requestContext(current_access, "agent"), &pb.RevokeAllSessionsMsg{ExceptCurrent: test.except_current})
+			if err != nil {
+				t.Fatalf("RevokeAllSessions() = %v", err)
+			}
+			if reply.Revoked != test.revoked {
+				t.Errorf("revoked %v sessions, want %v", reply.Revoked, test.revoked)
+			}
+
+			for _, access := range []string{first_access, second_access} {
+				_, err := s.GetGUID(requestContext(access, "agent"), &emptypb.Empty{})
+				assertCode(t, err, codes.Unauthenticated)
+			}
+			_, err = s.GetGUID(requestContext(current_access, "agent"), &emptypb.Empty{})
+			if test.except_current && err != nil {
+				t.Errorf("token of current session is rejected: %v", err)
+			}
+			if !test.except_current && err == nil {
+				t.Errorf("token of current session is accepted")
+			}
+			if _, err := s.GetGUID(requestContext(foreign_access, "agent"), &emptypb.Empty{}); err != nil {
+				t.Errorf("token of another user is rejected: %v", err)
+			}
+
+			//tokens of sessions which are not listed anymore are revoked by user marker
+			if _, ok := blacklist.users["user-guid"]; ok == test.except_current {
+				t.Errorf("user marker is set: %v", ok)
+			}
+		})
+	}
+}
+
+func TestSessionLimit(t *testing.T) {
+	tests := []struct {
+		policy string
+		code   codes.Code
+	}{
+		{auth.RejectPolicy, codes.ResourceExhausted},
+		{auth.EvictLRUPolicy, codes.OK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.policy, func(t *testing.T) {
+			s, db, blacklist := testServer(t)
+			s.SessionLimit = &auth.SessionLimit{MaxSessions: 2, Policy: test.policy}
+			oldest_id, _, _ := login(t, s, db, "user-guid", "agent")
+			newest_id, _, _ := login(t, s, db, "user-guid", "agent")
+			db.sessions[oldest_id].LastUsedAt -= 60
+			user, _ := db.GetUser("user-guid")
+
+			_, err := s.createSession(requestContext("", "agent"), user, "", nil, "", "", "agent", "127.0.0.1")
+			assertCode(t, err, test.code)
+
+			sessions, _ := db.ListSessions("user-guid")
+			if len(sessions) != 2 {
+				t.Fatalf("user has %v sessions, want 2", len(sessions))
+			}
+			if test.policy == auth.EvictLRUPolicy {
+				//the least recently used session is evicted together with its access tokens
+				if _, err := db.SearchSession("user-guid", oldest_id); err == nil || !blacklist.sessions[oldest_id] {
+					t.Errorf("session %v is not evicted", oldest_id)
+				}
+				if _, err := db.SearchSession("user-guid", newest_id); err != nil {
+					t.Errorf("session %v is evicted", newest_id)
+				}
+			}
+		})
+	}
+}
+
+func TestSessionLimitConcurrentLogins(t *testing.T) {
+	s, db, _ := testServer(t)
+	s.SessionLimit = &auth.SessionLimit{MaxSessions: 3, Policy: auth.RejectPolicy}
+	db.users["user-guid"] = &model.User{GUID: "user-guid"}
+	user, _ := db.GetUser("user-guid")
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.createSession(requestContext("", "agent"), user, "", nil, "", "", "agent", "127.0.0.1")
+		}()
+	}
+	wg.Wait()
+
+	if sessions, _ := db.ListSessions("user-guid"); len(sessions) != 3 {
+		t.Errorf("user has %v sessions, want 3", len(sessions))
+	}
+}
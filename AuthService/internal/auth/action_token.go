@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"AuthService/internal/model"
+)
+
+const (
+	PasswordResetPurpose       = "password_reset"
+	EmailVerificationPurpose   = "email_verification"
+	MFAChallengePurpose        = "mfa_challenge"
+	PasskeyRegistrationPurpose = "passkey_registration"
+	PasskeyLoginPurpose        = "passkey_login"
+	PasswordlessLoginPurpose   = "passwordless_login"
+	OAuthCodePurpose           = "oauth_code"
+
+	actionVerifierLength = 32
+	actionCodeDigits     = 6
+)
+
+// ActionTokenManager issues single-use tokens sent to user by notifications. Purpose is mixed into
+// HMAC, so token issued for one action can't be used for another.
+type ActionTokenManager interface {
+	GenerateActionToken(purpose string, guid string, ttl time.Duration) (*model.ActionToken, string, error)
+	GenerateActionCode(purpose string, guid string, ttl time.Duration) (*model.ActionToken, string, error)
+	VerifyActionToken(token string, action_token *model.ActionToken) bool
+}
+
+type action_token_manager struct {
+	Pepper []byte
+}
+
+func NewActionTokenManager(pepper string) *action_token_manager {
+	if pepper == "" {
+		log.Fatalf("action token pepper is empty")
+	}
+
+	return &action_token_manager{Pepper: []byte(pepper)}
+}
+
+// SplitActionToken returns selector of token, it is used to find stored token
+func SplitActionToken(token string) (string, string, error) {
+	selector, verifier, found := strings.Cut(token, ".")
+	if !found || selector == "" || verifier == "" {
+		return "", "", fmt.Errorf("invalid action token format")
+	}
+
+	return selector, verifier, nil
+}
+
+func (manager *action_token_manager) GenerateActionToken(purpose string, guid string, ttl time.Duration) (*model.ActionToken, string, error) {
+	selector, err := randomString(selectorLength)
+	if err != nil {
+		log.Errorf("failed to generate action token selector: %v", err)
+		return nil, "", err
+	}
+
+	verifier, err := randomString(actionVerifierLength)
+	if err != nil {
+		log.Errorf("failed to generate action token: %v", err)
+		return nil, "", err
+	}
+
+	now := time.Now()
+	action_token := model.ActionToken{
+		Purpose:      purpose,
+		Selector:     selector,
+		VerifierHash: manager.hashVerifier(purpose, verifier),
+		UserGUID:     guid,
+		CreatedAt:    now.Unix(),
+		ExpiresAt:    now.Add(ttl).Unix(),
+	}
+
+	return &action_token, selector + "." + verifier, nil
+}
+
+// GenerateActionCode is GenerateActionToken with short numeric verifier which user can type. Code alone is
+// guessable, so it must be checked together with selector and with limited attempts.
+func (manager *action_token_manager) GenerateActionCode(purpose string, guid string, ttl time.Duration) (*model.ActionToken, string, error) {
+	selector, err := randomString(selectorLength)
+	if err != nil {
+		log.Errorf("failed to generate action token selector: %v", err)
+		return nil, "", err
+	}
+
+	limit := big.NewInt(1)
+	for i := 0; i < actionCodeDigits; i++ {
+		limit.Mul(limit, big.NewInt(10))
+	}
+	number, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		log.Errorf("failed to generate action code: %v", err)
+		return nil, "", err
+	}
+	code := fmt.Sprintf("%0*d", actionCodeDigits, number)
+
+	now := time.Now()
+	action_token := model.ActionToken{
+		Purpose:      purpose,
+		Selector:     selector,
+		VerifierHash: manager.hashVerifier(purpose, code),
+		UserGUID:     guid,
+		CreatedAt:    now.Unix(),
+		ExpiresAt:    now.Add(ttl).Unix(),
+	}
+
+	return &action_token, selector + "." + code, nil
+}
+
+// VerifyActionToken checks verifier, expiry and that token was not used yet
+func (manager *action_token_manager) VerifyActionToken(token string, action_token *model.ActionToken) bool {
+	selector, verifier, err := SplitActionToken(token)
+	if err != nil || selector != action_token.Selector {
+		return false
+	}
+
+	if action_token.UsedAt != 0 || time.Now().Unix() >= action_token.ExpiresAt {
+		return false
+	}
+
+	expected, err := hex.DecodeString(action_token.VerifierHash)
+	if err != nil {
+		return false
+	}
+	actual, _ := hex.DecodeString(manager.hashVerifier(action_token.Purpose, verifier))
+
+	return hmac.Equal(actual, expected)
+}
+
+func (manager *action_token_manager) hashVerifier(purpose string, verifier string) string {
+	mac := hmac.New(sha256.New, manager.Pepper)
+	mac.Write([]byte(purpose + ":" + verifier))
+	return hex.EncodeToString(mac.Sum(nil))
+}
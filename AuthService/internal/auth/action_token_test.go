@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"AuthService/internal/model"
+)
+
+func TestVerifyActionToken(t *testing.T) {
+	manager := NewActionTokenManager("pepper")
+	action_token, token, err := manager.GenerateActionToken(PasswordResetPurpose, "guid", time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateActionToken() = %v", err)
+	}
+	selector, verifier, err := SplitActionToken(token)
+	if err != nil {
+		t.Fatalf("SplitActionToken() = %v", err)
+	}
+	if selector != action_token.Selector || action_token.UserGUID != "guid" || action_token.Purpose != PasswordResetPurpose {
+		t.Fatalf("GenerateActionToken() = %+v, token %q", action_token, token)
+	}
+	now := time.Now().Unix()
+
+	with := func(change func(*model.ActionToken)) model.ActionToken {
+		changed := *action_token
+		change(&changed)
+		return changed
+	}
+
+	tests := []struct {
+		name         string
+		token        string
+		action_token model.ActionToken
+		want         bool
+	}{
+		{"valid", token, *action_token, true},
+		{"wrong verifier", selector + ".wrong", *action_token, false},
+		{"wrong selector", "wrong." + verifier, *action_token, false},
+		{"no selector", verifier, *action_token, false},
+		{"used", token, with(func(a *model.ActionToken) { a.UsedAt = now }), false},
+		{"expired", token, with(func(a *model.ActionToken) { a.ExpiresAt = now - 1 }), false},
+		{"expiry boundary", token, with(func(a *model.ActionToken) { a.ExpiresAt = now }), false},
+		{"other purpose", token, with(func(a *model.ActionToken) { a.Purpose = EmailVerificationPurpose }), false},
+		{"broken hash", token, with(func(a *model.ActionToken) { a.VerifierHash = "not hex" }), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := manager.VerifyActionToken(test.token, &test.action_token); got != test.want {
+				t.Fatalf("VerifyActionToken() = %v, want %v", got, test.want)
+			}
+		})
+	}
+
+	if NewActionTokenManager("other pepper").VerifyActionToken(token, action_token) {
+		t.Errorf("token is accepted with other pepper")
+	}
+}
+
+func TestGenerateActionTokenUnique(t *testing.T) {
+	manager := NewActionTokenManager("pepper")
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		action_token, token, err := manager.GenerateActionToken(PasswordResetPurpose, "guid", time.Hour)
+		if err != nil {
+			t.Fatalf("GenerateActionToken() = %v", err)
+		}
+		if seen[action_token.Selector] || seen[token] {
+			t.Fatalf("token %q is generated twice", token)
+		}
+		seen[action_token.Selector] = true
+		seen[token] = true
+	}
+}
+
+func TestGenerateActionCode(t *testing.T) {
+	manager := NewActionTokenManager("pepper")
+	action_token, token, err := manager.GenerateActionCode(PasswordlessLoginPurpose, "", time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateActionCode() = %v", err)
+	}
+	selector, code, err := SplitActionToken(token)
+	if err != nil {
+		t.Fatalf("SplitActionToken() = %v", err)
+	}
+	if selector != action_token.Selector {
+		t.Fatalf("selector %q, want %q", selector, action_token.Selector)
+	}
+	if len(code) != 6 || strings.Trim(code, "0123456789") != "" {
+		t.Fatalf("code %q is not 6 digits", code)
+	}
+
+	other_code := "000000"
+	if code == other_code {
+		other_code = "000001"
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  bool
+	}{
+		{"valid", selector + "." + code, true},
+		{"wrong code", selector + "." + other_code, false},
+		{"code without selector", code, false},
+		{"code with other selector", "other." + code, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := manager.VerifyActionToken(test.token, action_token); got != test.want {
+				t.Fatalf("VerifyActionToken() = %v, want %v", got, test.want)
+			}
+		})
+	}
+
+	//the same code can't be replayed for another purpose
+	mfa_token := *action_token
+	mfa_token.Purpose = MFAChallengePurpose
+	if manager.VerifyActionToken(token, &mfa_token) {
+		t.Errorf("code is accepted for other purpose")
+	}
+}
+
+func TestGenerateActionCodeKeepsLeadingZeros(t *testing.T) {
+	manager := NewActionTokenManager("pepper")
+	for i := 0; i < 1000; i++ {
+		_, token, err := manager.GenerateActionCode(PasswordlessLoginPurpose, "", time.Minute)
+		if err != nil {
+			t.Fatalf("GenerateActionCode() = %v", err)
+		}
+		if _, code, _ := SplitActionToken(token); len(code) != 6 {
+			t.Fatalf("code %q is not 6 digits", code)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/url"
+	"strings"
+)
+
+const (
+	AuthorizationCodeGrant = "authorization_code"
+	CodeResponseType       = "code"
+	S256ChallengeMethod    = "S256"
+)
+
+// OAuth error codes from RFC 6749
+const (
+	InvalidRequestError          = "invalid_request"
+	InvalidClientError           = "invalid_client"
+	InvalidGrantError            = "invalid_grant"
+	InvalidScopeError            = "invalid_scope"
+	UnauthorizedClientError      = "unauthorized_client"
+	UnsupportedGrantTypeError    = "unsupported_grant_type"
+	UnsupportedResponseTypeError = "unsupported_response_type"
+)
+
+// AuthorizationRequest is kept with authorization code, token request must match it
+type AuthorizationRequest struct {
+	ClientID      string `json:"client_id"`
+	RedirectURI   string `json:"redirect_uri"`
+	CodeChallenge string `json:"code_challenge"`
+	Scope         string `json:"scope,omitempty"`
+	Nonce         string `json:"nonce,omitempty"`
+}
+
+// OAuthError is error response of token endpoint and error parameters of authorization response
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (err *OAuthError) Error() string {
+	return err.Code + ": " + err.Description
+}
+
+// TokenResponse is successful response of token endpoint
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// IsValidPKCEValue checks length and charset of code_verifier and S256 code_challenge (RFC 7636)
+func IsValidPKCEValue(value string) bool {
+	if len(value) < 43 || len(value) > 128 {
+		return false
+	}
+
+	for _, symbol := range value {
+		is_unreserved := (symbol >= 'a' && symbol <= 'z') || (symbol >= 'A' && symbol <= 'Z') || (symbol >= '0' && symbol <= '9') ||
+			symbol == '-' || symbol == '.' || symbol == '_' || symbol == '~'
+		if !is_unreserved {
+			return false
+		}
+	}
+
+	return true
+}
+
+// VerifyPKCE compares S256 challenge with verifier from token request
+func VerifyPKCE(code_challenge string, code_verifier string) bool {
+	if !IsValidPKCEValue(code_verifier) {
+		return false
+	}
+
+	hash := sha256.Sum256([]byte(code_verifier))
+	expected := base64.RawURLEncoding.EncodeToString(hash[:])
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(code_challenge)) == 1
+}
+
+// ParseBasicAuth reads client credentials from Basic authorization header, they are form-urlencoded (RFC 6749 2.3.1)
+func ParseBasicAuth(authorization string) (string, string, bool) {
+	prefix, encoded, found := strings.Cut(authorization, " ")
+	if !found || !strings.EqualFold(prefix, "Basic") {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", "", false
+	}
+	raw_id, raw_secret, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return "", "", false
+	}
+
+	client_id, err := url.QueryUnescape(raw_id)
+	if err != nil {
+		return "", "", false
+	}
+	client_secret, err := url.QueryUnescape(raw_secret)
+	if err != nil {
+		return "", "", false
+	}
+
+	return client_id, client_secret, true
+}
+
+// AuthorizationRedirect adds parameters to redirect uri keeping its own query
+func AuthorizationRedirect(redirect_uri string, params map[string]string) (string, error) {
+	redirect, err := url.Parse(redirect_uri)
+	if err != nil {
+		return "", err
+	}
+
+	query := redirect.Query()
+	for key, value := range params {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	redirect.RawQuery = query.Encode()
+
+	return redirect.String(), nil
+}
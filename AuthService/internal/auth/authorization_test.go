@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestVerifyPKCE(t *testing.T) {
+	//example of RFC 7636 appendix B
+	const verifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	const challenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	tests := []struct {
+		name      string
+		challenge string
+		verifier  string
+		valid     bool
+	}{
+		{"rfc 7636 example", challenge, verifier, true},
+		{"other verifier", challenge, strings.Replace(verifier, "d", "e", 1), false},
+		//plain method is not supported, verifier is never compared with challenge as is
+		{"plain challenge", verifier, verifier, false},
+		{"short verifier", challenge, verifier[:42], false},
+		{"long verifier", challenge, strings.Repeat("a", 129), false},
+		{"reserved symbol", challenge, verifier[:42] + "+", false},
+		{"empty verifier", challenge, "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if VerifyPKCE(test.challenge, test.verifier) != test.valid {
+				t.Fatalf("VerifyPKCE() = %v", !test.valid)
+			}
+		})
+	}
+}
+
+func TestParseBasicAuth(t *testing.T) {
+	basic := func(credentials string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		client_id     string
+		client_secret string
+		ok            bool
+	}{
+		{"credentials", basic("web-app:secret"), "web-app", "secret", true},
+		{"lowercase scheme", "basic " + base64.StdEncoding.EncodeToString([]byte("web-app:secret")), "web-app", "secret", true},
+		//credentials are form-urlencoded before base64 (RFC 6749 2.3.1)
+		{"encoded credentials", basic("web%3Aapp:se%2Bcret"), "web:app", "se+cret", true},
+		{"colon in secret", basic("web-app:se:cret"), "web-app", "se:cret", true},
+		{"bearer token", "Bearer token", "", "", false},
+		{"without colon", basic("web-app"), "", "", false},
+		{"invalid base64", "Basic !!!", "", "", false},
+		{"invalid escape", basic("web-app:%zz"), "", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client_id, client_secret, ok := ParseBasicAuth(test.authorization)
+			if ok != test.ok || client_id != test.client_id || client_secret != test.client_secret {
+				t.Fatalf("ParseBasicAuth() = %v, %v, %v", client_id, client_secret, ok)
+			}
+		})
+	}
+}
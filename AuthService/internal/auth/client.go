@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"AuthService/internal/model"
+)
+
+const (
+	RefreshTokenGrant  = "refresh_token"
+	clientSecretLength = 32
+)
+
+// SupportedGrantTypes can be allowed for client. refresh_token lets client refresh its sessions at Token endpoint
+// (POST /oauth/token), RefreshTokens doesn't accept sessions of clients.
+var SupportedGrantTypes = []string{AuthorizationCodeGrant, RefreshTokenGrant}
+
+// ClientSecretManager generates secrets of confidential clients. Secrets are random, so HMAC
+// with pepper is enough to store them, slow password hashing would only slow down token endpoint.
+type ClientSecretManager interface {
+	GenerateSecret() (string, string, error)
+	VerifySecret(secret string, client *model.OAuthClient) bool
+}
+
+type client_secret_manager struct {
+	Pepper []byte
+}
+
+func NewClientSecretManager(pepper string) *client_secret_manager {
+	if pepper == "" {
+		log.Fatalf("client secret pepper is empty")
+	}
+
+	return &client_secret_manager{Pepper: []byte(pepper)}
+}
+
+// GenerateSecret returns secret and its hash
+func (manager *client_secret_manager) GenerateSecret() (string, string, error) {
+	secret, err := randomString(clientSecretLength)
+	if err != nil {
+		log.Errorf("failed to generate client secret: %v", err)
+		return "", "", err
+	}
+
+	return secret, manager.hashSecret(secret), nil
+}
+
+func (manager *client_secret_manager) VerifySecret(secret string, client *model.OAuthClient) bool {
+	if client.SecretHash == "" || secret == "" {
+		return false
+	}
+
+	expected, err := hex.DecodeString(client.SecretHash)
+	if err != nil {
+		return false
+	}
+	actual, _ := hex.DecodeString(manager.hashSecret(secret))
+
+	return hmac.Equal(actual, expected)
+}
+
+func (manager *client_secret_manager) hashSecret(secret string) string {
+	mac := hmac.New(sha256.New, manager.Pepper)
+	mac.Write([]byte(secret))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsConfidential tells if client has secret and must authenticate on token endpoint
+func IsConfidential(client *model.OAuthClient) bool {
+	return client.SecretHash != ""
+}
+
+// HasRedirectURI compares redirect uri exactly, without any pattern matching
+func HasRedirectURI(client *model.OAuthClient, redirect_uri string) bool {
+	return HasScope(client.RedirectURIs, redirect_uri)
+}
+
+func HasGrantType(client *model.OAuthClient, grant_type string) bool {
+	return HasScope(client.GrantTypes, grant_type)
+}
+
+// AllowsScope checks that every requested scope is allowed for client
+func AllowsScope(client *model.OAuthClient, scope string) bool {
+	for _, item := range strings.Fields(scope) {
+		if !HasScope(client.Scopes, item) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var username_pattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{2,31}$`)
+
+// NormalizeEmail brings email to the form it is stored and searched in, so "User@Example.com " and
+// "user@example.com" are one account
+func NormalizeEmail(email string) (string, error) {
+	email = strings.ToLower(norm.NFKC.String(strings.TrimSpace(email)))
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", fmt.Errorf("invalid email")
+	}
+
+	return email, nil
+}
+
+// NormalizeUsername lowercases username, it can't contain @, so login identifier is never ambiguous
+func NormalizeUsername(username string) (string, error) {
+	username = strings.ToLower(norm.NFKC.String(strings.TrimSpace(username)))
+
+	if !username_pattern.MatchString(username) {
+		return "", fmt.Errorf("username must be 3-32 latin letters, digits, dots, dashes or underscores")
+	}
+
+	return username, nil
+}
+
+// NormalizeIdentifier normalizes login identifier as email if it has @ and as username otherwise
+func NormalizeIdentifier(identifier string) (string, error) {
+	if strings.Contains(identifier, "@") {
+		return NormalizeEmail(identifier)
+	}
+
+	return NormalizeUsername(identifier)
+}
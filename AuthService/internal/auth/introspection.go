@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"fmt"
+
+	"AuthService/internal/model"
+)
+
+const (
+	AccessTokenHint  = "access_token"
+	RefreshTokenHint = "refresh_token"
+)
+
+// IntrospectionResponse is RFC 7662 response. Inactive token is reported only with active=false,
+// so response does not tell why token is rejected.
+type IntrospectionResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Nbf       int64  `json:"nbf,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Aud       string `json:"aud,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+	SessionId string `json:"sid,omitempty"`
+}
+
+func NewAccessIntrospection(claims *TokenClaims) *IntrospectionResponse {
+	return &IntrospectionResponse{
+		Active:    true,
+		Scope:     claims.Scope,
+		ClientID:  claims.ClientID,
+		TokenType: AccessTokenHint,
+		Exp:       claims.ExpiresAt,
+		Iat:       claims.IssuedAt,
+		Nbf:       claims.NotBefore,
+		Sub:       claims.GUID,
+		Aud:       claims.Audience,
+		Iss:       claims.Issuer,
+		Jti:       claims.Id,
+		SessionId: fmt.Sprint(claims.SessionId),
+	}
+}
+
+func NewRefreshIntrospection(session *model.Session, expires_at int64) *IntrospectionResponse {
+	return &IntrospectionResponse{
+		Active:    true,
+		Scope:     session.Scope,
+		ClientID:  session.ClientID,
+		TokenType: RefreshTokenHint,
+		Exp:       expires_at,
+		Iat:       session.LastUsedAt,
+		Sub:       session.UserGUID,
+		SessionId: fmt.Sprint(session.ID),
+	}
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"AuthService/internal/model"
+)
+
+func testJWTManager(t *testing.T) *JWTManager {
+	t.Helper()
+	key, err := NewHMACKey(jwt.SigningMethodHS512, "secret key of at least 32 bytes long")
+	if err != nil {
+		t.Fatalf("NewHMACKey() = %v", err)
+	}
+
+	return &JWTManager{Keys: NewKeyRing(key, time.Hour), Issuer: "http://localhost:8080", Audiences: []string{"api"}, TokenDuration: time.Minute}
+}
+
+func TestAccessIntrospection(t *testing.T) {
+	manager := testJWTManager(t)
+	user := &model.User{GUID: "user-guid"}
+
+	tests := []struct {
+		name      string
+		client_id string
+		scope     string
+	}{
+		{"client token", "web-app", "openid profile"},
+		{"first-party token", "", "openid"},
+		{"no scope", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			token, err := manager.GenerateToken(user, 7, test.client_id, test.scope, time.Minute)
+			if err != nil {
+				t.Fatalf("GenerateToken() = %v", err)
+			}
+			claims, err := manager.VerifyToken("Bearer "+token, true)
+			if err != nil {
+				t.Fatalf("VerifyToken() = %v", err)
+			}
+
+			response := NewAccessIntrospection(claims)
+			if !response.Active || response.Scope != test.scope || response.ClientID != test.client_id {
+				t.Fatalf("NewAccessIntrospection() = %+v, want scope %q and client %q", response, test.scope, test.client_id)
+			}
+			if response.Sub != user.GUID || response.SessionId != "7" || response.TokenType != AccessTokenHint {
+				t.Fatalf("NewAccessIntrospection() = %+v", response)
+			}
+		})
+	}
+}
+
+func TestRefreshIntrospection(t *testing.T) {
+	session := &model.Session{ID: 7, UserGUID: "user-guid", ClientID: "web-app", Scope: "openid", LastUsedAt: 100}
+	response := NewRefreshIntrospection(session, 200)
+	if !response.Active || response.Scope != "openid" || response.ClientID != "web-app" || response.Exp != 200 || response.TokenType != RefreshTokenHint {
+		t.Fatalf("NewRefreshIntrospection() = %+v", response)
+	}
+}
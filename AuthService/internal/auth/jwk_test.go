@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/elliptic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+func TestThumbprint(t *testing.T) {
+	//example of RFC 7638 section 3.1
+	rfc_key := &JWK{
+		Kty: "RSA",
+		N:   "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		E:   "AQAB",
+	}
+
+	tests := []struct {
+		name string
+		jwk  *JWK
+		want string
+	}{
+		{"rfc 7638 example", rfc_key, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs"},
+		//optional members are not part of thumbprint
+		{"optional members", &JWK{Kty: rfc_key.Kty, N: rfc_key.N, E: rfc_key.E, Use: "sig", Alg: "RS256", Kid: "key"}, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs"},
+		{"unsupported type", &JWK{Kty: "oct"}, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			thumbprint, err := test.jwk.Thumbprint()
+			if test.want == "" {
+				if err == nil {
+					t.Fatalf("Thumbprint() = %v, want error", thumbprint)
+				}
+				return
+			}
+			if err != nil || thumbprint != test.want {
+				t.Fatalf("Thumbprint() = %v, %v, want %v", thumbprint, err, test.want)
+			}
+		})
+	}
+}
+
+func TestNewPublicJWK(t *testing.T) {
+	tests := []struct {
+		algorithm   string
+		private_key crypto.Signer
+		kty         string
+		crv         string
+	}{
+		{"RS256", generateRSA(t), "RSA", ""},
+		{"ES256", generateECDSA(t, elliptic.P256()), "EC", "P-256"},
+		{"ES512", generateECDSA(t, elliptic.P521()), "EC", "P-521"},
+		{"EdDSA", generateEd25519(t), "OKP", "Ed25519"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.algorithm, func(t *testing.T) {
+			method, err := ParseSigningMethod(test.algorithm)
+			if err != nil {
+				t.Fatalf("ParseSigningMethod() = %v", err)
+			}
+			private_path, public_path := writeKeyPair(t, test.private_key)
+			key, err := LoadKeyPair(method, private_path, public_path)
+			if err != nil {
+				t.Fatalf("LoadKeyPair() = %v", err)
+			}
+
+			jwk, err := NewPublicJWK(key)
+			if err != nil {
+				t.Fatalf("NewPublicJWK() = %v", err)
+			}
+			if jwk.Kty != test.kty || jwk.Crv != test.crv || jwk.Alg != test.algorithm || jwk.Use != "sig" {
+				t.Fatalf("NewPublicJWK() = %+v", jwk)
+			}
+
+			//kid of asymmetric key is thumbprint of its jwk
+			thumbprint, err := jwk.Thumbprint()
+			if err != nil {
+				t.Fatalf("Thumbprint() = %v", err)
+			}
+			if jwk.Kid != key.ID || key.ID != thumbprint {
+				t.Fatalf("kid %v, jwk kid %v, want thumbprint %v", key.ID, jwk.Kid, thumbprint)
+			}
+		})
+	}
+
+	hmac_key, err := NewHMACKey(jwt.SigningMethodHS256, "secret")
+	if err != nil {
+		t.Fatalf("NewHMACKey() = %v", err)
+	}
+	if _, err := NewPublicJWK(hmac_key); err == nil {
+		t.Errorf("NewPublicJWK() published hmac secret")
+	}
+}
+
+func TestPublicKeys(t *testing.T) {
+	method, err := ParseSigningMethod("ES256")
+	if err != nil {
+		t.Fatalf("ParseSigningMethod() = %v", err)
+	}
+	first_private, first_public := writeKeyPair(t, generateECDSA(t, elliptic.P256()))
+	first, err := LoadKeyPair(method, first_private, first_public)
+	if err != nil {
+		t.Fatalf("LoadKeyPair() = %v", err)
+	}
+	second_private, second_public := writeKeyPair(t, generateECDSA(t, elliptic.P256()))
+	second, err := LoadKeyPair(method, second_private, second_public)
+	if err != nil {
+		t.Fatalf("LoadKeyPair() = %v", err)
+	}
+
+	manager := &JWTManager{Keys: NewKeyRing(first, time.Hour)}
+	manager.Keys.Rotate(second)
+	keys := manager.PublicKeys()
+	if len(keys) != 2 || keys[0].Kid != second.ID || keys[1].Kid != first.ID {
+		t.Fatalf("PublicKeys() has %v keys, want current and retired", len(keys))
+	}
+
+	//hmac secret is never published
+	manager.Keys.Rotate(testHMACKey(t, "secret"))
+	keys = manager.PublicKeys()
+	for _, jwk := range keys {
+		if jwk.Kty != "EC" {
+			t.Errorf("PublicKeys() has %v key", jwk.Kty)
+		}
+	}
+	if len(keys) != 2 {
+		t.Errorf("PublicKeys() has %v keys, want 2 retired ec keys", len(keys))
+	}
+}
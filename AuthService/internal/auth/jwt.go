@@ -2,6 +2,7 @@ package auth
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -9,97 +10,239 @@ import (
 	"AuthService/source/utils"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 )
 
 type AuthManager interface {
-	GenerateToken(user *model.User, session_id uint) (string, error)
+	GenerateToken(user *model.User, session_id uint, client_id string, scope string, token_duration time.Duration) (string, error)
 	VerifyToken(user_token string, exparation_check bool) (*TokenClaims, error)
+	PublicKeys() []*JWK
+	GenerateIDToken(user *model.User, session_id uint, client_id string, nonce string) (string, error)
+	SigningAlgorithms() []string
+	GetTokenDuration() time.Duration
 }
 
+// TokenClaims has email_verified only for users with email and client_id with azp only for tokens issued
+// to client, scope is scope granted to session
 type TokenClaims struct {
-	GUID      string
-	SessionId uint
+	GUID            string
+	SessionId       uint
+	EmailVerified   *bool  `json:"email_verified,omitempty"`
+	ClientID        string `json:"client_id,omitempty"`
+	AuthorizedParty string `json:"azp,omitempty"`
+	Scope           string `json:"scope,omitempty"`
 	jwt.StandardClaims
 }
 
 type JWTManager struct {
-	SecretKey     string
+	Keys          *KeyRing
+	Issuer        string
+	Audiences     []string
+	Leeway        time.Duration
 	TokenDuration time.Duration
 }
 
 func NewJWTManager(token_duration time.Duration) *JWTManager {
-	secret := utils.GetKeyFromEnv("SECRET_KEY")
-	return &JWTManager{TokenDuration: token_duration, SecretKey: secret}
+	key, err := LoadKeyFromEnv()
+	if err != nil {
+		log.Fatalf("failed to load jwt signing key: %v", err)
+	}
+	log.Infof("jwt tokens are signed with %v, kid %v", key.Method.Alg(), key.ID)
+
+	grace_period, err := strconv.Atoi(utils.GetKeyFromEnv("JWT_KEY_GRACE_PERIOD"))
+	if err != nil {
+		log.Fatalf("invalid JWT_KEY_GRACE_PERIOD: %v", err)
+	}
+	if time.Duration(grace_period)*time.Minute < token_duration {
+		log.Warnf("JWT_KEY_GRACE_PERIOD is shorter than access token life time, rotation will invalidate some tokens")
+	}
+
+	issuer := strings.TrimSuffix(utils.GetKeyFromEnv("JWT_ISSUER"), "/")
+
+	audiences := []string{}
+	for _, audience := range strings.Split(utils.GetKeyFromEnv("JWT_AUDIENCE"), ",") {
+		if audience = strings.TrimSpace(audience); audience != "" {
+			audiences = append(audiences, audience)
+		}
+	}
+	if len(audiences) == 0 {
+		log.Fatalf("JWT_AUDIENCE is empty")
+	}
+
+	leeway, err := strconv.Atoi(utils.GetKeyFromEnv("JWT_LEEWAY"))
+	if err != nil {
+		log.Fatalf("invalid JWT_LEEWAY: %v", err)
+	}
+
+	return &JWTManager{
+		TokenDuration: token_duration,
+		Issuer:        issuer,
+		Audiences:     audiences,
+		Leeway:        time.Duration(leeway) * time.Second,
+		Keys:          NewKeyRing(key, time.Duration(grace_period)*time.Minute),
+	}
+}
+
+// LoadKeyFromEnv picks signing method from JWT_ALGORITHM. HMAC methods use SECRET_KEY,
+// asymmetric methods read PEM files from JWT_PRIVATE_KEY_PATH and JWT_PUBLIC_KEY_PATH
+func LoadKeyFromEnv() (*SigningKey, error) {
+	method, err := ParseSigningMethod(utils.GetKeyFromEnv("JWT_ALGORITHM"))
+	if err != nil {
+		return nil, err
+	}
+
+	if IsHMAC(method) {
+		return NewHMACKey(method, utils.GetKeyFromEnv("SECRET_KEY"))
+	}
+
+	return LoadKeyPair(method, utils.GetKeyFromEnv("JWT_PRIVATE_KEY_PATH"), utils.GetKeyFromEnv("JWT_PUBLIC_KEY_PATH"))
 }
 
-func (manager *JWTManager) GenerateToken(user *model.User, session_id uint) (string, error) {
+// GenerateToken issues access token for the first audience from JWT_AUDIENCE,
+// token_duration is ACCESS_LIFE_TIME or lifetime set for client
+func (manager *JWTManager) GenerateToken(user *model.User, session_id uint, client_id string, scope string, token_duration time.Duration) (string, error) {
+	now := time.Now()
 	claims := TokenClaims{
-		GUID:      user.GUID,
-		SessionId: session_id,
+		GUID:            user.GUID,
+		SessionId:       session_id,
+		EmailVerified:   emailVerified(user),
+		ClientID:        client_id,
+		AuthorizedParty: client_id,
+		Scope:           scope,
 		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(manager.TokenDuration).Unix()},
+			Id:        uuid.New().String(),
+			Issuer:    manager.Issuer,
+			Subject:   user.GUID,
+			Audience:  manager.Audiences[0],
+			IssuedAt:  now.Unix(),
+			NotBefore: now.Unix(),
+			ExpiresAt: now.Add(token_duration).Unix()},
+	}
+
+	key := manager.Keys.Current()
+	if key.PrivateKey == nil {
+		return "", fmt.Errorf("private key is not configured, tokens can only be verified")
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS512, claims)
-	signed_string, err := token.SignedString([]byte(manager.SecretKey))
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	signed_string, err := token.SignedString(key.PrivateKey)
 	if err != nil {
 		log.Errorf("failed to generate access token: %v", err)
-		return "", nil
+		return "", err
 	}
 
 	return signed_string, nil
 }
 
+func emailVerified(user *model.User) *bool {
+	if user.Email == nil {
+		return nil
+	}
+
+	verified := user.EmailVerified
+	return &verified
+}
+
 func (manager *JWTManager) VerifyToken(user_token string, exparation_check bool) (*TokenClaims, error) {
 	user_token, err := ExtractToken(user_token)
 	if err != nil {
 		return nil, err
 	}
-	token, err := jwt.ParseWithClaims(
+	//time based claims are checked in validateClaims with leeway, parser checks only signature
+	parser := jwt.Parser{SkipClaimsValidation: true}
+	token, err := parser.ParseWithClaims(
 		user_token,
 		&TokenClaims{},
 		func(t *jwt.Token) (interface{}, error) {
-			_, ok := t.Method.(*jwt.SigningMethodHMAC)
-			if !ok {
-				log.Errorf("failed to verify token: %v", err)
+			key_id, _ := t.Header["kid"].(string)
+			key, err := manager.Keys.Lookup(key_id)
+			if err != nil {
+				log.Errorf("failed to verify token: %v %v", err, key_id)
+				return nil, err
+			}
+
+			if t.Method.Alg() != key.Method.Alg() {
+				log.Errorf("failed to verify token: unexpected signing method %v", t.Method.Alg())
 				return nil, fmt.Errorf("wrong jwt encrypting method")
 			}
 
-			return []byte(manager.SecretKey), nil
+			return key.PublicKey, nil
 		},
 	)
 	if err != nil {
 		if validation_error, ok := err.(*jwt.ValidationError); ok {
-			if validation_error.Errors&jwt.ValidationErrorSignatureInvalid != 0{
+			if validation_error.Errors&jwt.ValidationErrorSignatureInvalid != 0 {
 				return nil, fmt.Errorf("invalid signature")
 			}
-
-			if validation_error.Errors&jwt.ValidationErrorExpired != 0 {
-				if exparation_check {
-					return nil, fmt.Errorf("token expired")
-				}
-				
-				claims, ok := token.Claims.(*TokenClaims)
-				if !ok {
-					return nil, fmt.Errorf("invalid token claims")
-				}
-			
-				return claims, nil
-			}
 		}
 		log.Errorf("invalid token: %v", err)
 		return nil, fmt.Errorf("invalid token")
 	}
 
 	claims, ok := token.Claims.(*TokenClaims)
-	if !ok {
+	if !ok || claims.GUID == "" {
 		return nil, fmt.Errorf("invalid token claims")
 	}
 
+	if err := manager.validateClaims(claims, exparation_check); err != nil {
+		return nil, err
+	}
+
 	return claims, nil
 }
 
+// with exparation_check set to false expired token is accepted, it is needed to refresh token pair
+func (manager *JWTManager) validateClaims(claims *TokenClaims, exparation_check bool) error {
+	now := time.Now()
+	leeway := int64(manager.Leeway / time.Second)
+
+	if exparation_check && !claims.VerifyExpiresAt(now.Unix()-leeway, true) {
+		return fmt.Errorf("token expired")
+	}
+
+	if !claims.VerifyNotBefore(now.Unix()+leeway, false) || !claims.VerifyIssuedAt(now.Unix()+leeway, false) {
+		return fmt.Errorf("token used before issued")
+	}
+
+	if !claims.VerifyIssuer(manager.Issuer, true) {
+		return fmt.Errorf("invalid token issuer")
+	}
+
+	for _, audience := range manager.Audiences {
+		if claims.VerifyAudience(audience, true) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid token audience")
+}
+
+func (manager *JWTManager) GetTokenDuration() time.Duration {
+	return manager.TokenDuration
+}
+
+// PublicKeys returns keys for JWKS. HMAC secrets are never published,
+// so with HMAC signing the list is empty.
+func (manager *JWTManager) PublicKeys() []*JWK {
+	keys := []*JWK{}
+	for _, key := range manager.Keys.VerificationKeys() {
+		if IsHMAC(key.Method) {
+			continue
+		}
+
+		jwk, err := NewPublicJWK(key)
+		if err != nil {
+			log.Errorf("failed to convert key %v to jwk: %v", key.ID, err)
+			continue
+		}
+		keys = append(keys, jwk)
+	}
+
+	return keys
+}
+
 func ExtractToken(bearerToken string) (string, error) {
 	if !strings.HasPrefix(bearerToken, "Bearer ") {
 		return "", fmt.Errorf("invalid token format")
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"AuthService/internal/model"
+)
+
+// signClaims signs claims with current key of manager, so tests can build tokens with any time and audience
+func signClaims(t *testing.T, manager *JWTManager, claims *TokenClaims) string {
+	t.Helper()
+	key := manager.Keys.Current()
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	signed_string, err := token.SignedString(key.PrivateKey)
+	if err != nil {
+		t.Fatalf("SignedString() = %v", err)
+	}
+	return signed_string
+}
+
+func TestVerifyTokenClaims(t *testing.T) {
+	manager := testJWTManager(t)
+	manager.Audiences = []string{"api", "billing"}
+	manager.Leeway = 30 * time.Second
+	now := time.Now().Unix()
+
+	valid := func(change func(claims *jwt.StandardClaims)) *TokenClaims {
+		claims := &TokenClaims{GUID: "user-guid", SessionId: 1, StandardClaims: jwt.StandardClaims{
+			Id:        "jti",
+			Issuer:    manager.Issuer,
+			Subject:   "user-guid",
+			Audience:  "api",
+			IssuedAt:  now,
+			NotBefore: now,
+			ExpiresAt: now + 60,
+		}}
+		change(&claims.StandardClaims)
+		return claims
+	}
+
+	tests := []struct {
+		name             string
+		claims           *TokenClaims
+		exparation_check bool
+		valid            bool
+	}{
+		{"valid", valid(func(claims *jwt.StandardClaims) {}), true, true},
+		{"second audience", valid(func(claims *jwt.StandardClaims) { claims.Audience = "billing" }), true, true},
+		{"foreign audience", valid(func(claims *jwt.StandardClaims) { claims.Audience = "other-api" }), true, false},
+		{"no audience", valid(func(claims *jwt.StandardClaims) { claims.Audience = "" }), true, false},
+		{"foreign issuer", valid(func(claims *jwt.StandardClaims) { claims.Issuer = "http://evil.example.com" }), true, false},
+		{"no issuer", valid(func(claims *jwt.StandardClaims) { claims.Issuer = "" }), true, false},
+		{"expired within leeway", valid(func(claims *jwt.StandardClaims) { claims.ExpiresAt = now - 20 }), true, true},
+		{"expired beyond leeway", valid(func(claims *jwt.StandardClaims) { claims.ExpiresAt = now - 40 }), true, false},
+		{"expired without expiration check", valid(func(claims *jwt.StandardClaims) { claims.ExpiresAt = now - 3600 }), false, true},
+		{"no expiration", valid(func(claims *jwt.StandardClaims) { claims.ExpiresAt = 0 }), true, false},
+		{"not before within leeway", valid(func(claims *jwt.StandardClaims) { claims.NotBefore = now + 20 }), true, true},
+		{"not before beyond leeway", valid(func(claims *jwt.StandardClaims) { claims.NotBefore = now + 40 }), true, false},
+		{"issued in future beyond leeway", valid(func(claims *jwt.StandardClaims) { claims.IssuedAt = now + 40 }), true, false},
+		{"future token without expiration check", valid(func(claims *jwt.StandardClaims) { claims.NotBefore = now + 40 }), false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			token := signClaims(t, manager, test.claims)
+			_, err := manager.VerifyToken("Bearer "+token, test.exparation_check)
+			if (err == nil) != test.valid {
+				t.Fatalf("VerifyToken() = %v, want valid %v", err, test.valid)
+			}
+		})
+	}
+}
+
+func TestGenerateTokenClaims(t *testing.T) {
+	manager := testJWTManager(t)
+	manager.Audiences = []string{"api", "billing"}
+	user := &model.User{GUID: "user-guid"}
+
+	token, err := manager.GenerateToken(user, 7, "web-app", "openid", 5*time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateToken() = %v", err)
+	}
+	claims, err := manager.VerifyToken("Bearer "+token, true)
+	if err != nil {
+		t.Fatalf("VerifyToken() = %v", err)
+	}
+
+	//access token is for the first audience, the rest are only accepted
+	if claims.Issuer != manager.Issuer || claims.Audience != "api" || claims.Subject != "user-guid" || claims.Id == "" {
+		t.Errorf("iss %v, aud %v, sub %v, jti %v", claims.Issuer, claims.Audience, claims.Subject, claims.Id)
+	}
+	if claims.NotBefore != claims.IssuedAt || claims.ExpiresAt != claims.IssuedAt+300 {
+		t.Errorf("iat %v, nbf %v, exp %v", claims.IssuedAt, claims.NotBefore, claims.ExpiresAt)
+	}
+	if claims.ClientID != "web-app" || claims.AuthorizedParty != "web-app" || claims.Scope != "openid" || claims.SessionId != 7 {
+		t.Errorf("client_id %v, azp %v, scope %v, session %v", claims.ClientID, claims.AuthorizedParty, claims.Scope, claims.SessionId)
+	}
+
+	other, err := manager.GenerateToken(user, 7, "web-app", "openid", 5*time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateToken() = %v", err)
+	}
+	other_claims, err := manager.VerifyToken("Bearer "+other, true)
+	if err != nil {
+		t.Fatalf("VerifyToken() = %v", err)
+	}
+	if other_claims.Id == claims.Id {
+		t.Errorf("two tokens have the same jti %v", claims.Id)
+	}
+}
+
+func TestVerifyTokenFormat(t *testing.T) {
+	manager := testJWTManager(t)
+	user := &model.User{GUID: "user-guid"}
+	token, err := manager.GenerateToken(user, 7, "", "", time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateToken() = %v", err)
+	}
+	other_manager := testJWTManager(t)
+	other_manager.Keys = NewKeyRing(testHMACKey(t, "another secret"), time.Hour)
+	foreign, err := other_manager.GenerateToken(user, 7, "", "", time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateToken() = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"without bearer prefix", token},
+		{"signed by unknown key", "Bearer " + foreign},
+		{"garbage", "Bearer not.a.token"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := manager.VerifyToken(test.token, true); err == nil {
+				t.Fatalf("VerifyToken() accepted token")
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// KeyRing keeps one current signing key and keys that were rotated out.
+// Rotated keys still verify tokens until their grace period ends, but never sign new ones.
+type KeyRing struct {
+	mutex       sync.RWMutex
+	current     *SigningKey
+	retired     map[string]*retired_key
+	GracePeriod time.Duration
+}
+
+type retired_key struct {
+	Key   *SigningKey
+	Until time.Time
+}
+
+func NewKeyRing(current *SigningKey, grace_period time.Duration) *KeyRing {
+	return &KeyRing{current: current, retired: make(map[string]*retired_key), GracePeriod: grace_period}
+}
+
+func (ring *KeyRing) Current() *SigningKey {
+	ring.mutex.RLock()
+	defer ring.mutex.RUnlock()
+
+	return ring.current
+}
+
+// Lookup returns key that can verify token with given kid. Empty kid means token was issued
+// before kid headers were introduced, such tokens are checked with current key.
+func (ring *KeyRing) Lookup(key_id string) (*SigningKey, error) {
+	ring.mutex.RLock()
+	defer ring.mutex.RUnlock()
+
+	if key_id == "" || key_id == ring.current.ID {
+		return ring.current, nil
+	}
+
+	retired, ok := ring.retired[key_id]
+	if !ok || time.Now().After(retired.Until) {
+		return nil, fmt.Errorf("unknown signing key")
+	}
+
+	return retired.Key, nil
+}
+
+// VerificationKeys returns current key first and then keys which are still in grace period
+func (ring *KeyRing) VerificationKeys() []*SigningKey {
+	ring.mutex.RLock()
+	defer ring.mutex.RUnlock()
+
+	keys := []*SigningKey{ring.current}
+	now := time.Now()
+	for _, retired := range ring.retired {
+		if now.Before(retired.Until) {
+			keys = append(keys, retired.Key)
+		}
+	}
+
+	return keys
+}
+
+// Rotate makes key current one. Previous current key is moved to grace period.
+// Rotating to the key which is already current does nothing.
+func (ring *KeyRing) Rotate(key *SigningKey) {
+	ring.mutex.Lock()
+	defer ring.mutex.Unlock()
+
+	if key.ID == ring.current.ID {
+		return
+	}
+
+	now := time.Now()
+	for key_id, retired := range ring.retired {
+		if now.After(retired.Until) {
+			delete(ring.retired, key_id)
+		}
+	}
+
+	delete(ring.retired, key.ID)
+	ring.retired[ring.current.ID] = &retired_key{Key: ring.current, Until: now.Add(ring.GracePeriod)}
+	log.Infof("signing key rotated from %v to %v, old key verifies tokens until %v", ring.current.ID, key.ID, now.Add(ring.GracePeriod))
+	ring.current = key
+}
+
+// WatchReload reloads signing key on SIGHUP and, if interval is not zero, periodically.
+// This way keys are rotated by replacing key files (or .env values) without restart.
+func (ring *KeyRing) WatchReload(interval time.Duration, load func() (*SigningKey, error)) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	var ticks <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		ticks = ticker.C
+	}
+
+	for {
+		select {
+		case <-signals:
+			log.Info("SIGHUP received, reloading signing key")
+		case <-ticks:
+		}
+
+		key, err := load()
+		if err != nil {
+			log.Errorf("failed to reload signing key, keeping %v: %v", ring.Current().ID, err)
+			continue
+		}
+		ring.Rotate(key)
+	}
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"AuthService/internal/model"
+)
+
+func testHMACKey(t *testing.T, secret string) *SigningKey {
+	t.Helper()
+	key, err := NewHMACKey(jwt.SigningMethodHS256, secret)
+	if err != nil {
+		t.Fatalf("NewHMACKey() = %v", err)
+	}
+	return key
+}
+
+func TestKeyRingLookup(t *testing.T) {
+	first := testHMACKey(t, "first secret")
+	second := testHMACKey(t, "second secret")
+	third := testHMACKey(t, "third secret")
+
+	ring := NewKeyRing(first, time.Hour)
+	ring.Rotate(second)
+	ring.Rotate(third)
+	//grace period of the first key is over
+	ring.retired[first.ID].Until = time.Now().Add(-time.Second)
+
+	tests := []struct {
+		name   string
+		key_id string
+		want   *SigningKey
+	}{
+		{"current key", third.ID, third},
+		{"token without kid", "", third},
+		{"key in grace period", second.ID, second},
+		{"grace period is over", first.ID, nil},
+		{"unknown key", "hmac-unknown", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := ring.Lookup(test.key_id)
+			if test.want == nil {
+				if err == nil {
+					t.Fatalf("Lookup() = %v, want error", key.ID)
+				}
+				return
+			}
+			if err != nil || key != test.want {
+				t.Fatalf("Lookup() = %v, want %v", err, test.want.ID)
+			}
+		})
+	}
+
+	keys := ring.VerificationKeys()
+	if len(keys) != 2 || keys[0] != third || keys[1] != second {
+		t.Errorf("VerificationKeys() has %v keys, want current and the one in grace period", len(keys))
+	}
+}
+
+func TestKeyRingRotate(t *testing.T) {
+	first := testHMACKey(t, "first secret")
+	second := testHMACKey(t, "second secret")
+
+	ring := NewKeyRing(first, time.Hour)
+	ring.Rotate(first)
+	if ring.Current() != first || len(ring.retired) != 0 {
+		t.Fatalf("rotation to current key changed the ring")
+	}
+
+	ring.Rotate(second)
+	if ring.Current() != second {
+		t.Fatalf("Current() = %v, want %v", ring.Current().ID, second.ID)
+	}
+	retired, ok := ring.retired[first.ID]
+	if !ok {
+		t.Fatalf("previous key is not in grace period")
+	}
+	if until := time.Until(retired.Until); until <= 59*time.Minute || until > time.Hour {
+		t.Errorf("grace period ends in %v, want an hour", until)
+	}
+
+	//returning to the retired key makes it current and removes it from grace period
+	ring.Rotate(first)
+	if ring.Current() != first {
+		t.Fatalf("Current() = %v, want %v", ring.Current().ID, first.ID)
+	}
+	if _, ok := ring.retired[first.ID]; ok {
+		t.Errorf("current key is still retired")
+	}
+	if _, ok := ring.retired[second.ID]; !ok {
+		t.Errorf("replaced key is not in grace period")
+	}
+}
+
+func TestKeyRingTokens(t *testing.T) {
+	user := &model.User{GUID: "user-guid"}
+	first := testHMACKey(t, "first secret")
+	second := testHMACKey(t, "second secret")
+	manager := &JWTManager{Keys: NewKeyRing(first, time.Hour), Issuer: "http://localhost:8080", Audiences: []string{"api"}, TokenDuration: time.Minute}
+
+	old_token, err := manager.GenerateToken(user, 1, "", "", time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateToken() = %v", err)
+	}
+	manager.Keys.Rotate(second)
+	new_token, err := manager.GenerateToken(user, 1, "", "", time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateToken() = %v", err)
+	}
+
+	parsed, _, err := new(jwt.Parser).ParseUnverified(new_token, &TokenClaims{})
+	if err != nil {
+		t.Fatalf("ParseUnverified() = %v", err)
+	}
+	if parsed.Header["kid"] != second.ID {
+		t.Errorf("new token kid = %v, want %v", parsed.Header["kid"], second.ID)
+	}
+
+	if _, err := manager.VerifyToken("Bearer "+old_token, true); err != nil {
+		t.Errorf("token of key in grace period is rejected: %v", err)
+	}
+	if _, err := manager.VerifyToken("Bearer "+new_token, true); err != nil {
+		t.Errorf("token of current key is rejected: %v", err)
+	}
+
+	manager.Keys.retired[first.ID].Until = time.Now().Add(-time.Second)
+	if _, err := manager.VerifyToken("Bearer "+old_token, true); err == nil {
+		t.Errorf("token of key after grace period is accepted")
+	}
+}
@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt"
+	log "github.com/sirupsen/logrus"
+)
+
+// SigningKey holds key material for one jwt signing method.
+// For HMAC methods PrivateKey and PublicKey are the same shared secret,
+// for asymmetric methods PrivateKey may be nil if service only verifies tokens.
+type SigningKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey interface{}
+	PublicKey  interface{}
+}
+
+func ParseSigningMethod(algorithm string) (jwt.SigningMethod, error) {
+	switch algorithm {
+	case "HS256", "HS384", "HS512", "RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "EdDSA":
+		return jwt.GetSigningMethod(algorithm), nil
+	}
+
+	return nil, fmt.Errorf("unsupported jwt algorithm: %v", algorithm)
+}
+
+func IsHMAC(method jwt.SigningMethod) bool {
+	_, ok := method.(*jwt.SigningMethodHMAC)
+	return ok
+}
+
+func NewHMACKey(method jwt.SigningMethod, secret string) (*SigningKey, error) {
+	if !IsHMAC(method) {
+		return nil, fmt.Errorf("%v is not hmac method", method.Alg())
+	}
+	if secret == "" {
+		return nil, fmt.Errorf("hmac secret is empty")
+	}
+
+	//kid must not leak the secret, so only part of its hash is used
+	hash := sha256.Sum256([]byte(secret))
+	key_id := fmt.Sprintf("hmac-%v", encodeSegment(hash[:12]))
+
+	return &SigningKey{ID: key_id, Method: method, PrivateKey: []byte(secret), PublicKey: []byte(secret)}, nil
+}
+
+// LoadKeyPair reads PEM encoded keys for asymmetric method. Public key is derived from
+// private key when public_key_path is empty, private_key_path can be empty for verify-only setups.
+func LoadKeyPair(method jwt.SigningMethod, private_key_path string, public_key_path string) (*SigningKey, error) {
+	if IsHMAC(method) {
+		return nil, fmt.Errorf("%v is not asymmetric method", method.Alg())
+	}
+	if private_key_path == "" && public_key_path == "" {
+		return nil, fmt.Errorf("no key files provided for %v", method.Alg())
+	}
+
+	key := SigningKey{Method: method}
+	if private_key_path != "" {
+		pem_data, err := os.ReadFile(private_key_path)
+		if err != nil {
+			log.Errorf("failed to read private key: %v", err)
+			return nil, err
+		}
+
+		private_key, err := parsePrivateKey(method, pem_data)
+		if err != nil {
+			log.Errorf("failed to parse private key: %v", err)
+			return nil, err
+		}
+		key.PrivateKey = private_key
+		key.PublicKey = private_key.(crypto.Signer).Public()
+	}
+
+	if public_key_path != "" {
+		pem_data, err := os.ReadFile(public_key_path)
+		if err != nil {
+			log.Errorf("failed to read public key: %v", err)
+			return nil, err
+		}
+
+		public_key, err := parsePublicKey(method, pem_data)
+		if err != nil {
+			log.Errorf("failed to parse public key: %v", err)
+			return nil, err
+		}
+		if key.PublicKey != nil && !publicKeysEqual(key.PublicKey, public_key) {
+			return nil, fmt.Errorf("public key does not match private key")
+		}
+		key.PublicKey = public_key
+	}
+
+	jwk, err := NewPublicJWK(&key)
+	if err != nil {
+		return nil, err
+	}
+	key.ID, err = jwk.Thumbprint()
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func parsePrivateKey(method jwt.SigningMethod, pem_data []byte) (crypto.PrivateKey, error) {
+	switch method.(type) {
+	case *jwt.SigningMethodRSA:
+		return jwt.ParseRSAPrivateKeyFromPEM(pem_data)
+	case *jwt.SigningMethodECDSA:
+		private_key, err := jwt.ParseECPrivateKeyFromPEM(pem_data)
+		if err != nil {
+			return nil, err
+		}
+		if private_key.Curve.Params().BitSize != method.(*jwt.SigningMethodECDSA).CurveBits {
+			return nil, fmt.Errorf("ecdsa curve does not match %v", method.Alg())
+		}
+		return private_key, nil
+	case *jwt.SigningMethodEd25519:
+		return jwt.ParseEdPrivateKeyFromPEM(pem_data)
+	}
+
+	return nil, fmt.Errorf("unsupported key type for %v", method.Alg())
+}
+
+func parsePublicKey(method jwt.SigningMethod, pem_data []byte) (crypto.PublicKey, error) {
+	switch method.(type) {
+	case *jwt.SigningMethodRSA:
+		return jwt.ParseRSAPublicKeyFromPEM(pem_data)
+	case *jwt.SigningMethodECDSA:
+		public_key, err := jwt.ParseECPublicKeyFromPEM(pem_data)
+		if err != nil {
+			return nil, err
+		}
+		if public_key.Curve.Params().BitSize != method.(*jwt.SigningMethodECDSA).CurveBits {
+			return nil, fmt.Errorf("ecdsa curve does not match %v", method.Alg())
+		}
+		return public_key, nil
+	case *jwt.SigningMethodEd25519:
+		return jwt.ParseEdPublicKeyFromPEM(pem_data)
+	}
+
+	return nil, fmt.Errorf("unsupported key type for %v", method.Alg())
+}
+
+func publicKeysEqual(first crypto.PublicKey, second crypto.PublicKey) bool {
+	switch key := first.(type) {
+	case *rsa.PublicKey:
+		return key.Equal(second)
+	case *ecdsa.PublicKey:
+		return key.Equal(second)
+	case ed25519.PublicKey:
+		return key.Equal(second)
+	}
+
+	return false
+}
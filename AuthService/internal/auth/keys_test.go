@@ -0,0 +1,205 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"AuthService/internal/model"
+)
+
+// writeKeyPair saves private key as PKCS8 and public key as PKIX PEM files, paths are returned in this order
+func writeKeyPair(t *testing.T, private_key crypto.Signer) (string, string) {
+	t.Helper()
+	private_der, err := x509.MarshalPKCS8PrivateKey(private_key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() = %v", err)
+	}
+	public_der, err := x509.MarshalPKIXPublicKey(private_key.Public())
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey() = %v", err)
+	}
+
+	dir := t.TempDir()
+	private_path := filepath.Join(dir, "private.pem")
+	public_path := filepath.Join(dir, "public.pem")
+	if err := os.WriteFile(private_path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: private_der}), 0600); err != nil {
+		t.Fatalf("failed to write private key: %v", err)
+	}
+	if err := os.WriteFile(public_path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public_der}), 0600); err != nil {
+		t.Fatalf("failed to write public key: %v", err)
+	}
+
+	return private_path, public_path
+}
+
+func generateRSA(t *testing.T) crypto.Signer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey() = %v", err)
+	}
+	return key
+}
+
+func generateECDSA(t *testing.T, curve elliptic.Curve) crypto.Signer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey() = %v", err)
+	}
+	return key
+}
+
+func generateEd25519(t *testing.T) crypto.Signer {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey() = %v", err)
+	}
+	return key
+}
+
+func TestParseSigningMethod(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		valid     bool
+	}{
+		{"HS256", true},
+		{"RS512", true},
+		{"ES384", true},
+		{"EdDSA", true},
+		{"none", false},
+		{"PS256", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.algorithm, func(t *testing.T) {
+			method, err := ParseSigningMethod(test.algorithm)
+			if (err == nil) != test.valid {
+				t.Fatalf("ParseSigningMethod() = %v, want valid %v", err, test.valid)
+			}
+			if test.valid && method.Alg() != test.algorithm {
+				t.Fatalf("ParseSigningMethod() = %v, want %v", method.Alg(), test.algorithm)
+			}
+		})
+	}
+}
+
+func TestLoadKeyPair(t *testing.T) {
+	user := &model.User{GUID: "user-guid"}
+
+	tests := []struct {
+		algorithm   string
+		private_key crypto.Signer
+	}{
+		{"RS256", generateRSA(t)},
+		{"ES256", generateECDSA(t, elliptic.P256())},
+		{"ES384", generateECDSA(t, elliptic.P384())},
+		{"ES512", generateECDSA(t, elliptic.P521())},
+		{"EdDSA", generateEd25519(t)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.algorithm, func(t *testing.T) {
+			method, err := ParseSigningMethod(test.algorithm)
+			if err != nil {
+				t.Fatalf("ParseSigningMethod() = %v", err)
+			}
+			private_path, public_path := writeKeyPair(t, test.private_key)
+
+			key, err := LoadKeyPair(method, private_path, public_path)
+			if err != nil {
+				t.Fatalf("LoadKeyPair() = %v", err)
+			}
+			manager := &JWTManager{Keys: NewKeyRing(key, time.Hour), Issuer: "http://localhost:8080", Audiences: []string{"api"}, TokenDuration: time.Minute}
+			token, err := manager.GenerateToken(user, 1, "", "", time.Minute)
+			if err != nil {
+				t.Fatalf("GenerateToken() = %v", err)
+			}
+			if _, err := manager.VerifyToken("Bearer "+token, true); err != nil {
+				t.Fatalf("VerifyToken() = %v", err)
+			}
+
+			//service which only verifies tokens has no private key
+			verify_only, err := LoadKeyPair(method, "", public_path)
+			if err != nil {
+				t.Fatalf("LoadKeyPair() without private key = %v", err)
+			}
+			if verify_only.ID != key.ID {
+				t.Errorf("kid of public key %v, want %v", verify_only.ID, key.ID)
+			}
+			verifier := &JWTManager{Keys: NewKeyRing(verify_only, time.Hour), Issuer: "http://localhost:8080", Audiences: []string{"api"}, TokenDuration: time.Minute}
+			if _, err := verifier.VerifyToken("Bearer "+token, true); err != nil {
+				t.Errorf("VerifyToken() with public key only = %v", err)
+			}
+			if _, err := verifier.GenerateToken(user, 1, "", "", time.Minute); err == nil {
+				t.Errorf("GenerateToken() without private key succeeded")
+			}
+		})
+	}
+}
+
+func TestLoadKeyPairMismatch(t *testing.T) {
+	rsa_private, _ := writeKeyPair(t, generateRSA(t))
+	_, other_rsa_public := writeKeyPair(t, generateRSA(t))
+	p256_private, _ := writeKeyPair(t, generateECDSA(t, elliptic.P256()))
+
+	tests := []struct {
+		name        string
+		algorithm   string
+		private_key string
+		public_key  string
+	}{
+		{"public key of another pair", "RS256", rsa_private, other_rsa_public},
+		{"curve of another algorithm", "ES384", p256_private, ""},
+		{"key of another type", "EdDSA", rsa_private, ""},
+		{"hmac algorithm", "HS256", rsa_private, ""},
+		{"no key files", "RS256", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			method, err := ParseSigningMethod(test.algorithm)
+			if err != nil {
+				t.Fatalf("ParseSigningMethod() = %v", err)
+			}
+			if _, err := LoadKeyPair(method, test.private_key, test.public_key); err == nil {
+				t.Fatalf("LoadKeyPair() succeeded")
+			}
+		})
+	}
+}
+
+func TestNewHMACKey(t *testing.T) {
+	key, err := NewHMACKey(jwt.SigningMethodHS256, "secret")
+	if err != nil {
+		t.Fatalf("NewHMACKey() = %v", err)
+	}
+	other, err := NewHMACKey(jwt.SigningMethodHS256, "another secret")
+	if err != nil {
+		t.Fatalf("NewHMACKey() = %v", err)
+	}
+	if key.ID == other.ID {
+		t.Errorf("different secrets have the same kid %v", key.ID)
+	}
+
+	if _, err := NewHMACKey(jwt.SigningMethodHS256, ""); err == nil {
+		t.Errorf("NewHMACKey() with empty secret succeeded")
+	}
+	if _, err := NewHMACKey(jwt.SigningMethodRS256, "secret"); err == nil {
+		t.Errorf("NewHMACKey() with rsa method succeeded")
+	}
+}
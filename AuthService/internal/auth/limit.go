@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"AuthService/source/utils"
+)
+
+const (
+	RejectPolicy   = "reject"
+	EvictLRUPolicy = "evict_lru"
+)
+
+// SessionLimit caps number of active sessions per user. When the cap is reached new login is either
+// rejected or the least recently used session is evicted. MaxSessions equal to zero disables the cap.
+type SessionLimit struct {
+	MaxSessions int
+	Policy      string
+}
+
+func NewSessionLimit() *SessionLimit {
+	max_sessions, err := strconv.Atoi(utils.GetKeyFromEnv("MAX_SESSIONS_PER_USER"))
+	if err != nil || max_sessions < 0 {
+		log.Fatalf("invalid MAX_SESSIONS_PER_USER: %v", utils.GetKeyFromEnv("MAX_SESSIONS_PER_USER"))
+	}
+
+	policy := utils.GetKeyFromEnv("SESSION_LIMIT_POLICY")
+	if policy != RejectPolicy && policy != EvictLRUPolicy {
+		log.Fatalf("invalid SESSION_LIMIT_POLICY: %v, use %v or %v", policy, RejectPolicy, EvictLRUPolicy)
+	}
+
+	return &SessionLimit{MaxSessions: max_sessions, Policy: policy}
+}
+
+// Excess returns how many sessions must be removed before one more session is added
+func (limit *SessionLimit) Excess(active_sessions int) int {
+	if limit.MaxSessions == 0 || active_sessions < limit.MaxSessions {
+		return 0
+	}
+
+	return active_sessions - limit.MaxSessions + 1
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	log "github.com/sirupsen/logrus"
+
+	"AuthService/internal/model"
+)
+
+const OpenIDScope = "openid"
+
+type IDTokenClaims struct {
+	AuthTime        int64  `json:"auth_time"`
+	Nonce           string `json:"nonce,omitempty"`
+	SessionId       string `json:"sid"`
+	AuthorizedParty string `json:"azp,omitempty"`
+	EmailVerified   *bool  `json:"email_verified,omitempty"`
+	jwt.StandardClaims
+}
+
+// DiscoveryDocument is OpenID Provider metadata served on /.well-known/openid-configuration
+type DiscoveryDocument struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+func NewDiscoveryDocument(issuer string, signing_algorithms []string) *DiscoveryDocument {
+	issuer = strings.TrimSuffix(issuer, "/")
+	return &DiscoveryDocument{
+		Issuer:                           issuer,
+		AuthorizationEndpoint:            issuer + "/oauth/authorize",
+		TokenEndpoint:                    issuer + "/oauth/token",
+		JWKSURI:                          issuer + "/.well-known/jwks.json",
+		IntrospectionEndpoint:            issuer + "/oauth/introspect",
+		RevocationEndpoint:               issuer + "/oauth/revoke",
+		SubjectTypesSupported:            []string{"public"},
+		IDTokenSigningAlgValuesSupported: signing_algorithms,
+		ScopesSupported:                  []string{OpenIDScope},
+		ClaimsSupported:                  []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "sid", "azp", "email_verified"},
+		ResponseTypesSupported:           []string{CodeResponseType},
+		GrantTypesSupported:              []string{AuthorizationCodeGrant, RefreshTokenGrant},
+		CodeChallengeMethodsSupported:    []string{S256ChallengeMethod},
+		//public clients are authenticated by PKCE only
+		TokenEndpointAuthMethodsSupported: []string{"none", "client_secret_basic", "client_secret_post"},
+	}
+}
+
+func HasScope(scope string, target string) bool {
+	for _, item := range strings.Fields(scope) {
+		if item == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateIDToken issues OpenID Connect ID token for client, its lifetime is the same as access token lifetime
+func (manager *JWTManager) GenerateIDToken(user *model.User, session_id uint, client_id string, nonce string) (string, error) {
+	now := time.Now()
+	claims := IDTokenClaims{
+		AuthTime:        now.Unix(),
+		Nonce:           nonce,
+		SessionId:       fmt.Sprint(session_id),
+		AuthorizedParty: client_id,
+		EmailVerified:   emailVerified(user),
+		StandardClaims: jwt.StandardClaims{
+			Issuer:    manager.Issuer,
+			Subject:   user.GUID,
+			Audience:  client_id,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(manager.TokenDuration).Unix(),
+		},
+	}
+
+	key := manager.Keys.Current()
+	if key.PrivateKey == nil {
+		return "", fmt.Errorf("private key is not configured, tokens can only be verified")
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	signed_string, err := token.SignedString(key.PrivateKey)
+	if err != nil {
+		log.Errorf("failed to generate id token: %v", err)
+		return "", err
+	}
+
+	return signed_string, nil
+}
+
+// SigningAlgorithms lists algorithms of keys in key ring without duplicates, current key first
+func (manager *JWTManager) SigningAlgorithms() []string {
+	algorithms := []string{}
+	seen := make(map[string]bool)
+	for _, key := range manager.Keys.VerificationKeys() {
+		if !seen[key.Method.Alg()] {
+			seen[key.Method.Alg()] = true
+			algorithms = append(algorithms, key.Method.Alg())
+		}
+	}
+
+	return algorithms
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"AuthService/internal/model"
+)
+
+func TestGenerateIDToken(t *testing.T) {
+	manager := testJWTManager(t)
+	email := "user@example.com"
+	verified := true
+
+	tests := []struct {
+		name           string
+		user           *model.User
+		client_id      string
+		nonce          string
+		email_verified *bool
+	}{
+		{"user without email", &model.User{GUID: "user-guid"}, "web-app", "n-0S6_WzA2Mj", nil},
+		{"unverified email", &model.User{GUID: "user-guid", Email: &email}, "web-app", "", new(bool)},
+		{"verified email", &model.User{GUID: "user-guid", Email: &email, EmailVerified: true}, "mobile-app", "nonce", &verified},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			before := time.Now().Unix()
+			id_token, err := manager.GenerateIDToken(test.user, 7, test.client_id, test.nonce)
+			if err != nil {
+				t.Fatalf("GenerateIDToken() = %v", err)
+			}
+
+			claims := &IDTokenClaims{}
+			token, err := jwt.ParseWithClaims(id_token, claims, func(token *jwt.Token) (interface{}, error) {
+				return manager.Keys.Current().PublicKey, nil
+			})
+			if err != nil || !token.Valid {
+				t.Fatalf("ParseWithClaims() = %v", err)
+			}
+			if token.Header["kid"] != manager.Keys.Current().ID {
+				t.Errorf("kid = %v, want %v", token.Header["kid"], manager.Keys.Current().ID)
+			}
+
+			if claims.Issuer != manager.Issuer || claims.Subject != test.user.GUID || claims.SessionId != "7" {
+				t.Errorf("iss %v, sub %v, sid %v", claims.Issuer, claims.Subject, claims.SessionId)
+			}
+			//ID token is for client, not for resource servers from JWT_AUDIENCE
+			if claims.Audience != test.client_id || claims.AuthorizedParty != test.client_id {
+				t.Errorf("aud %v, azp %v, want %v", claims.Audience, claims.AuthorizedParty, test.client_id)
+			}
+			if claims.Nonce != test.nonce {
+				t.Errorf("nonce %v, want %v", claims.Nonce, test.nonce)
+			}
+			if claims.AuthTime < before || claims.IssuedAt < before || claims.ExpiresAt != claims.IssuedAt+int64(manager.TokenDuration/time.Second) {
+				t.Errorf("auth_time %v, iat %v, exp %v", claims.AuthTime, claims.IssuedAt, claims.ExpiresAt)
+			}
+
+			switch {
+			case test.email_verified == nil && claims.EmailVerified != nil:
+				t.Errorf("email_verified = %v for user without email", *claims.EmailVerified)
+			case test.email_verified != nil && (claims.EmailVerified == nil || *claims.EmailVerified != *test.email_verified):
+				t.Errorf("email_verified = %v, want %v", claims.EmailVerified, *test.email_verified)
+			}
+
+			//ID token is not accepted as access token
+			if _, err := manager.VerifyToken("Bearer "+id_token, true); err == nil {
+				t.Errorf("VerifyToken() accepted ID token")
+			}
+		})
+	}
+}
+
+func TestNewDiscoveryDocument(t *testing.T) {
+	document := NewDiscoveryDocument("http://localhost:8080/", []string{"ES256", "RS256"})
+	if document.Issuer != "http://localhost:8080" {
+		t.Errorf("issuer %v, want it without trailing slash", document.Issuer)
+	}
+	if document.JWKSURI != "http://localhost:8080/.well-known/jwks.json" || document.TokenEndpoint != "http://localhost:8080/oauth/token" {
+		t.Errorf("jwks_uri %v, token_endpoint %v", document.JWKSURI, document.TokenEndpoint)
+	}
+	if len(document.IDTokenSigningAlgValuesSupported) != 2 || document.IDTokenSigningAlgValuesSupported[0] != "ES256" {
+		t.Errorf("id_token_signing_alg_values_supported = %v", document.IDTokenSigningAlgValuesSupported)
+	}
+}
+
+func TestSigningAlgorithms(t *testing.T) {
+	manager := testJWTManager(t)
+	manager.Keys.Rotate(testHMACKey(t, "another secret"))
+	manager.Keys.Rotate(testHMACKey(t, "third secret"))
+
+	algorithms := manager.SigningAlgorithms()
+	if len(algorithms) != 2 || algorithms[0] != "HS256" || algorithms[1] != "HS512" {
+		t.Fatalf("SigningAlgorithms() = %v, want current algorithm first without duplicates", algorithms)
+	}
+}
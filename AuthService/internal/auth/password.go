@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/argon2"
+
+	"AuthService/source/utils"
+)
+
+type PasswordHasher interface {
+	HashPassword(password string) (string, error)
+	VerifyPassword(password string, hash string) (bool, error)
+	NeedsRehash(hash string) bool
+}
+
+// argon2_hasher stores hashes in PHC format "$argon2id$v=19$m=65536,t=3,p=2$salt$key",
+// parameters are read from hash on verification, so they can be tuned without breaking old passwords
+type argon2_hasher struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+func NewArgon2Hasher() *argon2_hasher {
+	memory, err := strconv.ParseUint(utils.GetKeyFromEnv("ARGON2_MEMORY"), 10, 32)
+	if err != nil || memory < 8*1024 {
+		log.Fatalf("invalid ARGON2_MEMORY: %v, at least 8192 KiB is required", utils.GetKeyFromEnv("ARGON2_MEMORY"))
+	}
+
+	iterations, err := strconv.ParseUint(utils.GetKeyFromEnv("ARGON2_ITERATIONS"), 10, 32)
+	if err != nil || iterations == 0 {
+		log.Fatalf("invalid ARGON2_ITERATIONS: %v", utils.GetKeyFromEnv("ARGON2_ITERATIONS"))
+	}
+
+	parallelism, err := strconv.ParseUint(utils.GetKeyFromEnv("ARGON2_PARALLELISM"), 10, 8)
+	if err != nil || parallelism == 0 {
+		log.Fatalf("invalid ARGON2_PARALLELISM: %v", utils.GetKeyFromEnv("ARGON2_PARALLELISM"))
+	}
+
+	return &argon2_hasher{Memory: uint32(memory), Iterations: uint32(iterations), Parallelism: uint8(parallelism), SaltLength: 16, KeyLength: 32}
+}
+
+func (hasher *argon2_hasher) HashPassword(password string) (string, error) {
+	salt := make([]byte, hasher.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		log.Errorf("failed to generate salt: %v", err)
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, hasher.Iterations, hasher.Memory, hasher.Parallelism, hasher.KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, hasher.Memory, hasher.Iterations, hasher.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func (hasher *argon2_hasher) VerifyPassword(password string, hash string) (bool, error) {
+	params, salt, key, err := decodeArgon2Hash(hash)
+	if err != nil {
+		log.Errorf("failed to decode password hash: %v", err)
+		return false, err
+	}
+
+	actual := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(actual, key) == 1, nil
+}
+
+// NeedsRehash reports that hash was made with other parameters, it is replaced after successful login
+func (hasher *argon2_hasher) NeedsRehash(hash string) bool {
+	params, salt, key, err := decodeArgon2Hash(hash)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory != hasher.Memory || params.Iterations != hasher.Iterations || params.Parallelism != hasher.Parallelism ||
+		uint32(len(salt)) != hasher.SaltLength || uint32(len(key)) != hasher.KeyLength
+}
+
+func decodeArgon2Hash(hash string) (*argon2_hasher, []byte, []byte, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, nil, nil, fmt.Errorf("not an argon2id hash")
+	}
+
+	version := 0
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, nil, nil, fmt.Errorf("unsupported argon2 version: %v", parts[2])
+	}
+
+	params := argon2_hasher{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid argon2 parameters: %v", err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid argon2 salt: %v", err)
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid argon2 key: %v", err)
+	}
+
+	return &params, salt, key, nil
+}
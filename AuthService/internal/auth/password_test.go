@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+func testArgon2Hasher() *argon2_hasher {
+	return &argon2_hasher{Memory: 8 * 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+}
+
+func TestArgon2HashPassword(t *testing.T) {
+	hasher := testArgon2Hasher()
+	first, err := hasher.HashPassword("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("HashPassword() = %v", err)
+	}
+	second, err := hasher.HashPassword("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("HashPassword() = %v", err)
+	}
+
+	if !strings.HasPrefix(first, "$argon2id$v=19$m=8192,t=1,p=1$") {
+		t.Errorf("hash %q is not in PHC format", first)
+	}
+	if first == second {
+		t.Errorf("hashes of the same password are equal, salt is not random")
+	}
+}
+
+func TestArgon2VerifyPassword(t *testing.T) {
+	hasher := testArgon2Hasher()
+	hash, err := hasher.HashPassword("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("HashPassword() = %v", err)
+	}
+	//hash made with other parameters is verified with parameters from the hash itself
+	old_hash, err := (&argon2_hasher{Memory: 16 * 1024, Iterations: 2, Parallelism: 2, SaltLength: 8, KeyLength: 16}).HashPassword("old password")
+	if err != nil {
+		t.Fatalf("HashPassword() = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		hash     string
+		want     bool
+		wantErr  bool
+	}{
+		{"correct", "correct horse battery staple", hash, true, false},
+		{"wrong", "correct horse battery stapler", hash, false, false},
+		{"empty", "", hash, false, false},
+		{"old parameters", "old password", old_hash, true, false},
+		{"bcrypt hash", "password", "$2a$10$7EqJtq98hPqEX7fNZaFWoOhi5BWX4Z2zA0Gy4uNMwUIKrSCCeXsiS", false, true},
+		{"unsupported version", "password", strings.Replace(hash, "v=19", "v=16", 1), false, true},
+		{"broken parameters", "password", strings.Replace(hash, "m=8192,t=1,p=1", "m=x", 1), false, true},
+		{"broken salt", "password", "$argon2id$v=19$m=8192,t=1,p=1$!!!$AAAA", false, true},
+		{"truncated", "password", "$argon2id$v=19$m=8192,t=1,p=1", false, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := hasher.VerifyPassword(test.password, test.hash)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("VerifyPassword() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Fatalf("VerifyPassword() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestArgon2NeedsRehash(t *testing.T) {
+	hasher := testArgon2Hasher()
+	hash, err := hasher.HashPassword("password")
+	if err != nil {
+		t.Fatalf("HashPassword() = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		hasher *argon2_hasher
+		hash   string
+		want   bool
+	}{
+		{"same parameters", hasher, hash, false},
+		{"more memory", &argon2_hasher{Memory: 16 * 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}, hash, true},
+		{"more iterations", &argon2_hasher{Memory: 8 * 1024, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32}, hash, true},
+		{"other parallelism", &argon2_hasher{Memory: 8 * 1024, Iterations: 1, Parallelism: 2, SaltLength: 16, KeyLength: 32}, hash, true},
+		{"longer key", &argon2_hasher{Memory: 8 * 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 64}, hash, true},
+		{"bcrypt hash", hasher, "$2a$10$7EqJtq98hPqEX7fNZaFWoOhi5BWX4Z2zA0Gy4uNMwUIKrSCCeXsiS", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.hasher.NeedsRehash(test.hash); got != test.want {
+				t.Fatalf("NeedsRehash() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
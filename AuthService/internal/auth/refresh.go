@@ -1,38 +1,164 @@
 package auth
 
 import (
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+
+	"AuthService/internal/model"
+	"AuthService/source/utils"
+)
+
+const selectorLength = 16
+
+var (
+	ErrRefreshExpired = errors.New("refresh token expired")
+	ErrRefreshIdle    = errors.New("refresh token expired after inactivity")
 )
 
 type RefreshManager interface {
-	GenerateRefreshToken() (string, error)
+	GenerateRefreshToken() (*RefreshToken, error)
+	HashVerifier(verifier string) string
+	VerifyRefresh(refresh string, session *model.Session) bool
 	GetExparationTime() time.Duration
+	GetIdleTime() time.Duration
+	CheckExpiry(session *model.Session) error
+	SessionExpiresAt(session *model.Session) int64
+}
+
+// RefreshToken is given to client as "selector.verifier". Selector finds session row,
+// only HMAC of verifier is stored, so leaked sessions table can't be used to refresh.
+type RefreshToken struct {
+	Selector string
+	Verifier string
+}
+
+func (token *RefreshToken) String() string {
+	return token.Selector + "." + token.Verifier
+}
+
+// ParseRefreshToken splits token into selector and verifier. Legacy tokens have no selector,
+// for them error is returned and session has to be found by digest of the whole token.
+func ParseRefreshToken(refresh string) (*RefreshToken, error) {
+	selector, verifier, found := strings.Cut(refresh, ".")
+	if !found || selector == "" || verifier == "" {
+		return nil, fmt.Errorf("refresh token has no selector")
+	}
+
+	return &RefreshToken{Selector: selector, Verifier: verifier}, nil
 }
 
 type refresh_generator struct {
 	RefreshLength  int64
 	ExparationTime time.Duration
+	IdleTime       time.Duration
+	Pepper         []byte
 }
 
-func NewRefreshGenerator(refresh_length int64, exparation_time time.Duration) *refresh_generator {
-	refresh_generator := refresh_generator{RefreshLength: refresh_length, ExparationTime: exparation_time}
+// exparation_time is absolute session lifetime counted from login, it is not extended by refreshes.
+// idle_time limits time between refreshes, zero idle_time disables idle expiry.
+// pepper is HMAC key for verifiers, changing it invalidates all refresh tokens.
+func NewRefreshGenerator(refresh_length int64, exparation_time time.Duration, idle_time time.Duration, pepper string) *refresh_generator {
+	if pepper == "" {
+		log.Fatalf("refresh pepper is empty")
+	}
+	refresh_generator := refresh_generator{RefreshLength: refresh_length, ExparationTime: exparation_time, IdleTime: idle_time, Pepper: []byte(pepper)}
 	return &refresh_generator
 }
 
-func (generator *refresh_generator) GenerateRefreshToken() (string, error) {
-	bytes := make([]byte, generator.RefreshLength)
-	_, err := rand.Read(bytes)
+func (generator *refresh_generator) GenerateRefreshToken() (*RefreshToken, error) {
+	selector, err := randomString(selectorLength)
+	if err != nil {
+		log.Errorf("failed to generate refresh selector: %v", err)
+		return nil, err
+	}
+
+	verifier, err := randomString(generator.RefreshLength)
 	if err != nil {
 		log.Errorf("failed to generate refresh token: %v", err)
-		return "", err
+		return nil, err
+	}
+
+	return &RefreshToken{Selector: selector, Verifier: verifier}, nil
+}
+
+func (generator *refresh_generator) HashVerifier(verifier string) string {
+	mac := hmac.New(sha256.New, generator.Pepper)
+	mac.Write([]byte(verifier))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyRefresh checks refresh token against session found by its selector or digest.
+// Sessions created before selector tokens keep bcrypt hash of the whole token, they are
+// checked with bcrypt until they expire or are rotated into new format.
+func (generator *refresh_generator) VerifyRefresh(refresh string, session *model.Session) bool {
+	if session.Selector == nil || *session.Selector == "" {
+		if !strings.HasPrefix(session.Refresh, "$2") {
+			return false
+		}
+		return utils.CompareHashAndPassword(refresh, session.Refresh) == nil
 	}
-	return base64.StdEncoding.EncodeToString(bytes), nil
+
+	token, err := ParseRefreshToken(refresh)
+	if err != nil || token.Selector != *session.Selector {
+		return false
+	}
+
+	expected, err := hex.DecodeString(session.Refresh)
+	if err != nil {
+		return false
+	}
+	actual, _ := hex.DecodeString(generator.HashVerifier(token.Verifier))
+
+	return hmac.Equal(actual, expected)
 }
 
 func (generator *refresh_generator) GetExparationTime() time.Duration {
 	return generator.ExparationTime
 }
+
+func (generator *refresh_generator) GetIdleTime() time.Duration {
+	return generator.IdleTime
+}
+
+func (generator *refresh_generator) CheckExpiry(session *model.Session) error {
+	now := time.Now().Unix()
+	if now >= session.ExpiresAt {
+		return ErrRefreshExpired
+	}
+
+	if generator.IdleTime > 0 && now >= session.LastUsedAt+int64(generator.IdleTime/time.Second) {
+		return ErrRefreshIdle
+	}
+
+	return nil
+}
+
+// SessionExpiresAt returns unix time when session stops being refreshable if it is not used before
+func (generator *refresh_generator) SessionExpiresAt(session *model.Session) int64 {
+	if generator.IdleTime > 0 {
+		idle_expires_at := session.LastUsedAt + int64(generator.IdleTime/time.Second)
+		if idle_expires_at < session.ExpiresAt {
+			return idle_expires_at
+		}
+	}
+
+	return session.ExpiresAt
+}
+
+func randomString(length int64) (string, error) {
+	bytes := make([]byte, length)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
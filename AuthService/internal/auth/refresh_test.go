@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"AuthService/internal/model"
+	"AuthService/source/utils"
+)
+
+func TestCheckExpiry(t *testing.T) {
+	now := time.Now().Unix()
+	idle := int64(time.Hour / time.Second)
+
+	tests := []struct {
+		name      string
+		idle_time time.Duration
+		session   model.Session
+		want      error
+	}{
+		{"active", time.Hour, model.Session{LastUsedAt: now, ExpiresAt: now + 3600}, nil},
+		{"absolute expired", time.Hour, model.Session{LastUsedAt: now, ExpiresAt: now - 1}, ErrRefreshExpired},
+		{"absolute boundary", time.Hour, model.Session{LastUsedAt: now, ExpiresAt: now}, ErrRefreshExpired},
+		{"absolute before idle", time.Hour, model.Session{LastUsedAt: now - 2*idle, ExpiresAt: now - 1}, ErrRefreshExpired},
+		{"idle expired", time.Hour, model.Session{LastUsedAt: now - 2*idle, ExpiresAt: now + 3600}, ErrRefreshIdle},
+		{"idle boundary", time.Hour, model.Session{LastUsedAt: now - idle, ExpiresAt: now + 3600}, ErrRefreshIdle},
+		{"idle just before boundary", time.Hour, model.Session{LastUsedAt: now - idle + 5, ExpiresAt: now + 3600}, nil},
+		{"idle disabled", 0, model.Session{LastUsedAt: now - 2*idle, ExpiresAt: now + 3600}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			generator := NewRefreshGenerator(32, 24*time.Hour, test.idle_time, "pepper")
+			err := generator.CheckExpiry(&test.session)
+			if !errors.Is(err, test.want) {
+				t.Fatalf("CheckExpiry() = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestSessionExpiresAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		idle_time time.Duration
+		session   model.Session
+		want      int64
+	}{
+		{"idle first", time.Hour, model.Session{LastUsedAt: 1000, ExpiresAt: 100000}, 4600},
+		{"absolute first", time.Hour, model.Session{LastUsedAt: 1000, ExpiresAt: 2000}, 2000},
+		{"idle disabled", 0, model.Session{LastUsedAt: 1000, ExpiresAt: 100000}, 100000},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			generator := NewRefreshGenerator(32, 24*time.Hour, test.idle_time, "pepper")
+			if got := generator.SessionExpiresAt(&test.session); got != test.want {
+				t.Fatalf("SessionExpiresAt() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestVerifyRefresh(t *testing.T) {
+	generator := NewRefreshGenerator(32, 24*time.Hour, time.Hour, "pepper")
+	token, err := generator.GenerateRefreshToken()
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken() = %v", err)
+	}
+	session := model.Session{Selector: &token.Selector, Refresh: generator.HashVerifier(token.Verifier)}
+
+	legacy_token := "legacy-refresh-token"
+	legacy_hash, err := utils.GenerateHash(legacy_token)
+	if err != nil {
+		t.Fatalf("GenerateHash() = %v", err)
+	}
+	empty_selector := ""
+
+	tests := []struct {
+		name    string
+		refresh string
+		session model.Session
+		want    bool
+	}{
+		{"valid", token.String(), session, true},
+		{"wrong verifier", token.Selector + ".wrong", session, false},
+		{"wrong selector", "wrong." + token.Verifier, session, false},
+		{"no selector", token.Verifier, session, false},
+		{"other pepper", token.String(), model.Session{Selector: &token.Selector, Refresh: NewRefreshGenerator(32, time.Hour, 0, "other").HashVerifier(token.Verifier)}, false},
+		{"legacy", legacy_token, model.Session{Refresh: legacy_hash}, true},
+		{"legacy with empty selector", legacy_token, model.Session{Selector: &empty_selector, Refresh: legacy_hash}, true},
+		{"legacy wrong token", "wrong", model.Session{Refresh: legacy_hash}, false},
+		{"legacy without bcrypt hash", token.String(), model.Session{Refresh: generator.HashVerifier(token.Verifier)}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := generator.VerifyRefresh(test.refresh, &test.session); got != test.want {
+				t.Fatalf("VerifyRefresh() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"AuthService/source/utils"
+)
+
+const (
+	totpPeriod       = 30
+	totpDigits       = 6
+	totpSecretLength = 20
+
+	RecoveryCodesCount   = 10
+	recoveryCodeLength   = 10
+	recoveryCodeAlphabet = "abcdefghijkmnpqrstuvwxyz23456789"
+)
+
+// TOTPManager implements RFC 6238 codes with SHA-1, 6 digits and 30 second step, the only variant
+// every authenticator app supports. Secrets are stored encrypted with AES-GCM, recovery codes as HMAC.
+type TOTPManager interface {
+	GenerateSecret() (string, error)
+	EncryptSecret(secret string) (string, error)
+	DecryptSecret(encrypted string) (string, error)
+	URI(account string, secret string) string
+	ValidateCode(secret string, code string) (int64, bool)
+	GenerateRecoveryCodes() ([]string, []string, error)
+	HashRecoveryCode(code string) string
+}
+
+type totp_manager struct {
+	Cipher      cipher.AEAD
+	RecoveryKey []byte
+	Issuer      string
+	Skew        int64
+}
+
+// NewTOTPManager reads TOTP_ENCRYPTION_KEY (32 bytes hex), TOTP_ISSUER shown in authenticator app
+// and TOTP_SKEW, number of steps accepted before and after current one
+func NewTOTPManager() *totp_manager {
+	key, err := hex.DecodeString(utils.GetKeyFromEnv("TOTP_ENCRYPTION_KEY"))
+	if err != nil || len(key) != 32 {
+		log.Fatalf("TOTP_ENCRYPTION_KEY must be 32 bytes in hex")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		log.Fatalf("failed to init totp cipher: %v", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		log.Fatalf("failed to init totp cipher: %v", err)
+	}
+
+	skew, err := strconv.Atoi(utils.GetKeyFromEnv("TOTP_SKEW"))
+	if err != nil || skew < 0 {
+		log.Fatalf("invalid TOTP_SKEW: %v", utils.GetKeyFromEnv("TOTP_SKEW"))
+	}
+
+	//encryption key is not used as HMAC key directly, recovery key is derived from it
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("recovery codes"))
+
+	return &totp_manager{Cipher: aead, RecoveryKey: mac.Sum(nil), Issuer: utils.GetKeyFromEnv("TOTP_ISSUER"), Skew: int64(skew)}
+}
+
+func (manager *totp_manager) GenerateSecret() (string, error) {
+	secret := make([]byte, totpSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		log.Errorf("failed to generate totp secret: %v", err)
+		return "", err
+	}
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(secret), nil
+}
+
+// EncryptSecret returns base64 of nonce followed by ciphertext
+func (manager *totp_manager) EncryptSecret(secret string) (string, error) {
+	nonce := make([]byte, manager.Cipher.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := manager.Cipher.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (manager *totp_manager) DecryptSecret(encrypted string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < manager.Cipher.NonceSize() {
+		return "", fmt.Errorf("encrypted totp secret is too short")
+	}
+
+	nonce, ciphertext := sealed[:manager.Cipher.NonceSize()], sealed[manager.Cipher.NonceSize():]
+	secret, err := manager.Cipher.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		log.Errorf("failed to decrypt totp secret: %v", err)
+		return "", err
+	}
+
+	return string(secret), nil
+}
+
+// URI is otpauth:// link which authenticator apps read from QR code
+func (manager *totp_manager) URI(account string, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", manager.Issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", strconv.Itoa(totpDigits))
+	query.Set("period", strconv.Itoa(totpPeriod))
+
+	label := url.PathEscape(manager.Issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// ValidateCode returns time step of matched code, caller must reject steps which were already used
+func (manager *totp_manager) ValidateCode(secret string, code string) (int64, bool) {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := time.Now().Unix() / totpPeriod
+	for step := current - manager.Skew; step <= current+manager.Skew; step++ {
+		if hmac.Equal([]byte(totpCode(key, step)), []byte(code)) {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// totpCode is HOTP value of RFC 4226 for counter step
+func totpCode(key []byte, step int64) string {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%modulo)
+}
+
+// GenerateRecoveryCodes returns codes shown to user once and their hashes for storage.
+// Codes look like "k7m2p-x9qrt", ambiguous characters are not used.
+func (manager *totp_manager) GenerateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, RecoveryCodesCount)
+	hashes := make([]string, 0, RecoveryCodesCount)
+	for i := 0; i < RecoveryCodesCount; i++ {
+		random := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(random); err != nil {
+			log.Errorf("failed to generate recovery code: %v", err)
+			return nil, nil, err
+		}
+
+		code := make([]byte, recoveryCodeLength)
+		for j, value := range random {
+			code[j] = recoveryCodeAlphabet[int(value)%len(recoveryCodeAlphabet)]
+		}
+
+		formatted := string(code[:recoveryCodeLength/2]) + "-" + string(code[recoveryCodeLength/2:])
+		codes = append(codes, formatted)
+		hashes = append(hashes, manager.HashRecoveryCode(formatted))
+	}
+
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode ignores case, spaces and dashes, so code can be typed the way user likes
+func (manager *totp_manager) HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+
+	mac := hmac.New(sha256.New, manager.RecoveryKey)
+	mac.Write([]byte(normalized))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsTOTPCode tells TOTP code from recovery code entered in the same field
+func IsTOTPCode(code string) bool {
+	if len(code) != totpDigits {
+		return false
+	}
+	for _, char := range code {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return true
+}
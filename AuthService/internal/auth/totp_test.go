@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base32"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testTOTPManager(t *testing.T, skew int64) *totp_manager {
+	t.Helper()
+	block, err := aes.NewCipher(make([]byte, 32))
+	if err != nil {
+		t.Fatalf("failed to init cipher: %v", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatalf("failed to init cipher: %v", err)
+	}
+
+	return &totp_manager{Cipher: aead, RecoveryKey: []byte("recovery key"), Issuer: "AuthService", Skew: skew}
+}
+
+// RFC 6238 appendix B vectors for SHA-1, last 6 of 8 digits
+func TestTOTPCode(t *testing.T) {
+	key := []byte("12345678901234567890")
+	tests := []struct {
+		time int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, test := range tests {
+		if got := totpCode(key, test.time/totpPeriod); got != test.want {
+			t.Errorf("totpCode(%v) = %v, want %v", test.time, got, test.want)
+		}
+	}
+}
+
+func TestValidateCode(t *testing.T) {
+	manager := testTOTPManager(t, 1)
+	secret, err := manager.GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() = %v", err)
+	}
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatalf("secret is not base32: %v", err)
+	}
+
+	//step must not change while the test runs
+	if time.Now().Unix()%totpPeriod >= totpPeriod-2 {
+		time.Sleep(3 * time.Second)
+	}
+	current := time.Now().Unix() / totpPeriod
+
+	tests := []struct {
+		name   string
+		step   int64
+		wantOk bool
+	}{
+		{"current step", current, true},
+		{"previous step within skew", current - 1, true},
+		{"next step within skew", current + 1, true},
+		{"too old", current - 2, false},
+		{"too far ahead", current + 2, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			step, ok := manager.ValidateCode(secret, totpCode(key, test.step))
+			if ok != test.wantOk {
+				t.Fatalf("ValidateCode() ok = %v, want %v", ok, test.wantOk)
+			}
+			//returned step is stored, so the code can't be replayed
+			if ok && step != test.step {
+				t.Fatalf("ValidateCode() step = %v, want %v", step, test.step)
+			}
+		})
+	}
+
+	strict := testTOTPManager(t, 0)
+	if _, ok := strict.ValidateCode(secret, totpCode(key, current-1)); ok {
+		t.Errorf("code of previous step is accepted without skew")
+	}
+	if _, ok := manager.ValidateCode(secret, "12345"); ok {
+		t.Errorf("short code is accepted")
+	}
+	if _, ok := manager.ValidateCode("not base32!", totpCode(key, current)); ok {
+		t.Errorf("code is accepted for broken secret")
+	}
+}
+
+func TestEncryptSecret(t *testing.T) {
+	manager := testTOTPManager(t, 1)
+	encrypted, err := manager.EncryptSecret("JBSWY3DPEHPK3PXP")
+	if err != nil {
+		t.Fatalf("EncryptSecret() = %v", err)
+	}
+	if strings.Contains(encrypted, "JBSWY3DPEHPK3PXP") {
+		t.Fatalf("secret is stored in plain text")
+	}
+
+	secret, err := manager.DecryptSecret(encrypted)
+	if err != nil || secret != "JBSWY3DPEHPK3PXP" {
+		t.Fatalf("DecryptSecret() = %q, %v", secret, err)
+	}
+
+	sealed, _ := base64.StdEncoding.DecodeString(encrypted)
+	sealed[len(sealed)-1] ^= 1
+	if _, err := manager.DecryptSecret(base64.StdEncoding.EncodeToString(sealed)); err == nil {
+		t.Errorf("tampered secret is decrypted")
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	manager := testTOTPManager(t, 1)
+	codes, hashes, err := manager.GenerateRecoveryCodes()
+	if err != nil {
+		t.Fatalf("GenerateRecoveryCodes() = %v", err)
+	}
+	if len(codes) != RecoveryCodesCount || len(hashes) != RecoveryCodesCount {
+		t.Fatalf("got %v codes and %v hashes, want %v", len(codes), len(hashes), RecoveryCodesCount)
+	}
+
+	seen := map[string]bool{}
+	for i, code := range codes {
+		if len(code) != recoveryCodeLength+1 || code[recoveryCodeLength/2] != '-' {
+			t.Errorf("code %q has wrong format", code)
+		}
+		if strings.Trim(strings.Replace(code, "-", "", 1), recoveryCodeAlphabet) != "" {
+			t.Errorf("code %q has characters outside of alphabet", code)
+		}
+		if IsTOTPCode(code) {
+			t.Errorf("code %q looks like totp code", code)
+		}
+		if seen[hashes[i]] {
+			t.Errorf("code %q is generated twice", code)
+		}
+		seen[hashes[i]] = true
+		if hashes[i] != manager.HashRecoveryCode(code) {
+			t.Errorf("hash of %q doesn't match stored hash", code)
+		}
+	}
+
+	code := codes[0]
+	typed := []string{strings.ToUpper(code), strings.Replace(code, "-", "", 1), " " + code[:3] + " " + code[3:]}
+	for _, variant := range typed {
+		if manager.HashRecoveryCode(variant) != hashes[0] {
+			t.Errorf("code typed as %q is not recognized", variant)
+		}
+	}
+	if manager.HashRecoveryCode(codes[1]) == hashes[0] {
+		t.Errorf("different codes have the same hash")
+	}
+}
+
+func TestIsTOTPCode(t *testing.T) {
+	tests := map[string]bool{"123456": true, "000000": true, "12345": false, "1234567": false, "12345a": false, "k7m2p-x9qrt": false, "": false}
+	for code, want := range tests {
+		if got := IsTOTPCode(code); got != want {
+			t.Errorf("IsTOTPCode(%q) = %v, want %v", code, got, want)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	log "github.com/sirupsen/logrus"
+
+	"AuthService/internal/model"
+	"AuthService/source/utils"
+)
+
+// PasskeyOwnerLookup finds user by WebAuthn user handle, which is GUID of user
+type PasskeyOwnerLookup func(user_handle []byte) (*model.User, []model.WebAuthnCredential, error)
+
+// PasskeyManager runs WebAuthn ceremonies. Begin methods return options for navigator.credentials
+// as JSON and session data which must be kept until finish, finish methods take JSON of
+// PublicKeyCredential from browser. Login is discoverable, user is found by user handle of passkey.
+type PasskeyManager interface {
+	BeginRegistration(user *model.User, credentials []model.WebAuthnCredential) ([]byte, string, error)
+	FinishRegistration(user *model.User, credentials []model.WebAuthnCredential, session string, response []byte) (*model.WebAuthnCredential, error)
+	BeginLogin() ([]byte, string, error)
+	FinishLogin(session string, response []byte, lookup PasskeyOwnerLookup) (*model.User, *model.WebAuthnCredential, error)
+	GetTimeout() time.Duration
+}
+
+type passkey_manager struct {
+	WebAuthn *webauthn.WebAuthn
+	Timeout  time.Duration
+}
+
+// NewPasskeyManager reads relying party from WEBAUTHN_RP_ID (domain without scheme and port),
+// WEBAUTHN_RP_NAME and WEBAUTHN_RP_ORIGINS. Passkeys are created as discoverable and user
+// verification is required, so passkey alone is enough to login.
+func NewPasskeyManager() *passkey_manager {
+	timeout, err := strconv.Atoi(utils.GetKeyFromEnv("WEBAUTHN_TIMEOUT"))
+	if err != nil || timeout <= 0 {
+		log.Fatalf("invalid WEBAUTHN_TIMEOUT: %v", utils.GetKeyFromEnv("WEBAUTHN_TIMEOUT"))
+	}
+
+	origins := []string{}
+	for _, origin := range strings.Split(utils.GetKeyFromEnv("WEBAUTHN_RP_ORIGINS"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+
+	ceremony_timeout := webauthn.TimeoutConfig{Enforce: true, Timeout: time.Duration(timeout) * time.Minute, TimeoutUVD: time.Duration(timeout) * time.Minute}
+	web_authn, err := webauthn.New(&webauthn.Config{
+		RPID:          utils.GetKeyFromEnv("WEBAUTHN_RP_ID"),
+		RPDisplayName: utils.GetKeyFromEnv("WEBAUTHN_RP_NAME"),
+		RPOrigins:     origins,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationRequired,
+		},
+		Timeouts: webauthn.TimeoutsConfig{Login: ceremony_timeout, Registration: ceremony_timeout},
+	})
+	if err != nil {
+		log.Fatalf("invalid webauthn config: %v", err)
+	}
+
+	return &passkey_manager{WebAuthn: web_authn, Timeout: time.Duration(timeout) * time.Minute}
+}
+
+func (manager *passkey_manager) GetTimeout() time.Duration {
+	return manager.Timeout
+}
+
+// BeginRegistration excludes passkeys user already has, so the same authenticator is not registered twice
+func (manager *passkey_manager) BeginRegistration(user *model.User, credentials []model.WebAuthnCredential) ([]byte, string, error) {
+	owner := newPasskeyOwner(user, credentials)
+	exclusions := []protocol.CredentialDescriptor{}
+	for _, credential := range owner.WebAuthnCredentials() {
+		exclusions = append(exclusions, credential.Descriptor())
+	}
+
+	creation, session, err := manager.WebAuthn.BeginRegistration(owner,
+		webauthn.WithExclusions(exclusions),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+	)
+	if err != nil {
+		log.Errorf("failed to begin passkey registration: %v", err)
+		return nil, "", err
+	}
+
+	return encodeCeremony(creation, session)
+}
+
+func (manager *passkey_manager) FinishRegistration(user *model.User, credentials []model.WebAuthnCredential, session string, response []byte) (*model.WebAuthnCredential, error) {
+	session_data := webauthn.SessionData{}
+	if err := json.Unmarshal([]byte(session), &session_data); err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(response))
+	if err != nil {
+		return nil, describeWebAuthnError(err)
+	}
+
+	credential, err := manager.WebAuthn.CreateCredential(newPasskeyOwner(user, credentials), session_data, parsed)
+	if err != nil {
+		return nil, describeWebAuthnError(err)
+	}
+
+	transports := []string{}
+	for _, transport := range credential.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	return &model.WebAuthnCredential{
+		UserGUID:        user.GUID,
+		CredentialID:    credential.ID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transports:      strings.Join(transports, ","),
+		AAGUID:          credential.Authenticator.AAGUID,
+		SignCount:       credential.Authenticator.SignCount,
+		BackupEligible:  credential.Flags.BackupEligible,
+		BackupState:     credential.Flags.BackupState,
+		CreatedAt:       time.Now().Unix(),
+	}, nil
+}
+
+func (manager *passkey_manager) BeginLogin() ([]byte, string, error) {
+	assertion, session, err := manager.WebAuthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		log.Errorf("failed to begin passkey login: %v", err)
+		return nil, "", err
+	}
+
+	return encodeCeremony(assertion, session)
+}
+
+// FinishLogin returns owner of passkey and credential with updated sign count. Sign count which
+// didn't grow means the key may be cloned, such login is rejected.
+func (manager *passkey_manager) FinishLogin(session string, response []byte, lookup PasskeyOwnerLookup) (*model.User, *model.WebAuthnCredential, error) {
+	session_data := webauthn.SessionData{}
+	if err := json.Unmarshal([]byte(session), &session_data); err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(response))
+	if err != nil {
+		return nil, nil, describeWebAuthnError(err)
+	}
+
+	var user *model.User
+	var credentials []model.WebAuthnCredential
+	handler := func(raw_id []byte, user_handle []byte) (webauthn.User, error) {
+		user, credentials, err = lookup(user_handle)
+		if err != nil {
+			return nil, err
+		}
+		return newPasskeyOwner(user, credentials), nil
+	}
+
+	credential, err := manager.WebAuthn.ValidateDiscoverableLogin(handler, session_data, parsed)
+	if err != nil {
+		return nil, nil, describeWebAuthnError(err)
+	}
+	if credential.Authenticator.CloneWarning {
+		return nil, nil, fmt.Errorf("sign count of passkey did not grow, authenticator may be cloned")
+	}
+
+	for _, stored := range credentials {
+		if string(stored.CredentialID) == string(credential.ID) {
+			stored.SignCount = credential.Authenticator.SignCount
+			stored.BackupState = credential.Flags.BackupState
+			stored.LastUsedAt = time.Now().Unix()
+			return user, &stored, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("passkey not found")
+}
+
+func encodeCeremony(options interface{}, session *webauthn.SessionData) ([]byte, string, error) {
+	options_json, err := json.Marshal(options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	session_json, err := json.Marshal(session)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return options_json, string(session_json), nil
+}
+
+// describeWebAuthnError keeps details of protocol errors, they tell client what exactly is wrong
+func describeWebAuthnError(err error) error {
+	if protocol_error, ok := err.(*protocol.Error); ok && protocol_error.Details != "" {
+		return fmt.Errorf("%v: %v", protocol_error.Type, protocol_error.Details)
+	}
+
+	return err
+}
+
+// passkey_owner adapts user to webauthn.User, user handle is GUID
+type passkey_owner struct {
+	User        *model.User
+	Credentials []webauthn.Credential
+}
+
+func newPasskeyOwner(user *model.User, credentials []model.WebAuthnCredential) *passkey_owner {
+	owner := passkey_owner{User: user}
+	for _, stored := range credentials {
+		transports := []protocol.AuthenticatorTransport{}
+		for _, transport := range strings.Split(stored.Transports, ",") {
+			if transport != "" {
+				transports = append(transports, protocol.AuthenticatorTransport(transport))
+			}
+		}
+
+		owner.Credentials = append(owner.Credentials, webauthn.Credential{
+			ID:              stored.CredentialID,
+			PublicKey:       stored.PublicKey,
+			AttestationType: stored.AttestationType,
+			Transport:       transports,
+			Flags:           webauthn.CredentialFlags{BackupEligible: stored.BackupEligible, BackupState: stored.BackupState},
+			Authenticator:   webauthn.Authenticator{AAGUID: stored.AAGUID, SignCount: stored.SignCount},
+		})
+	}
+
+	return &owner
+}
+
+func (owner *passkey_owner) WebAuthnID() []byte {
+	return []byte(owner.User.GUID)
+}
+
+func (owner *passkey_owner) WebAuthnName() string {
+	if owner.User.Email != nil {
+		return *owner.User.Email
+	}
+	if owner.User.Username != nil {
+		return *owner.User.Username
+	}
+
+	return owner.User.GUID
+}
+
+func (owner *passkey_owner) WebAuthnDisplayName() string {
+	return owner.WebAuthnName()
+}
+
+// WebAuthnIcon is required by webauthn.User, icon is not used by browsers anymore
+func (owner *passkey_owner) WebAuthnIcon() string {
+	return ""
+}
+
+func (owner *passkey_owner) WebAuthnCredentials() []webauthn.Credential {
+	return owner.Credentials
+}
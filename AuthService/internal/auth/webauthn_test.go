@@ -0,0 +1,307 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"AuthService/internal/model"
+)
+
+const (
+	testRPID   = "auth.example.com"
+	testOrigin = "https://auth.example.com"
+
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttestedData = 0x40
+)
+
+func testPasskeyManager(t *testing.T) *passkey_manager {
+	t.Helper()
+	timeout := webauthn.TimeoutConfig{Enforce: true, Timeout: time.Minute, TimeoutUVD: time.Minute}
+	web_authn, err := webauthn.New(&webauthn.Config{
+		RPID:          testRPID,
+		RPDisplayName: "AuthService",
+		RPOrigins:     []string{testOrigin},
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationRequired,
+		},
+		Timeouts: webauthn.TimeoutsConfig{Login: timeout, Registration: timeout},
+	})
+	if err != nil {
+		t.Fatalf("invalid webauthn config: %v", err)
+	}
+
+	return &passkey_manager{WebAuthn: web_authn, Timeout: time.Minute}
+}
+
+// soft_authenticator is software passkey with P-256 key and "none" attestation
+type soft_authenticator struct {
+	t            *testing.T
+	Key          *ecdsa.PrivateKey
+	CredentialID []byte
+	UserHandle   []byte
+	SignCount    uint32
+}
+
+func newSoftAuthenticator(t *testing.T) *soft_authenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	credential_id := make([]byte, 16)
+	if _, err := rand.Read(credential_id); err != nil {
+		t.Fatalf("failed to generate credential id: %v", err)
+	}
+
+	return &soft_authenticator{t: t, Key: key, CredentialID: credential_id}
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// challengeOf reads challenge from options returned by Begin methods
+func challengeOf(t *testing.T, options []byte) string {
+	t.Helper()
+	parsed := struct {
+		PublicKey struct {
+			Challenge string `json:"challenge"`
+		} `json:"publicKey"`
+	}{}
+	if err := json.Unmarshal(options, &parsed); err != nil || parsed.PublicKey.Challenge == "" {
+		t.Fatalf("options have no challenge: %v", err)
+	}
+
+	return parsed.PublicKey.Challenge
+}
+
+func clientData(ceremony string, challenge string) []byte {
+	data, _ := json.Marshal(map[string]string{"type": ceremony, "challenge": challenge, "origin": testOrigin})
+	return data
+}
+
+func (authenticator *soft_authenticator) authData(flags byte) []byte {
+	rp_id_hash := sha256.Sum256([]byte(testRPID))
+	data := append(rp_id_hash[:], flags)
+	return binary.BigEndian.AppendUint32(data, authenticator.SignCount)
+}
+
+// Register returns PublicKeyCredential JSON for navigator.credentials.create
+func (authenticator *soft_authenticator) Register(challenge string, user_handle []byte) []byte {
+	authenticator.UserHandle = user_handle
+	public_key, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{KeyType: int64(webauthncose.EllipticKey), Algorithm: int64(webauthncose.AlgES256)},
+		Curve:         1,
+		XCoord:        authenticator.Key.X.FillBytes(make([]byte, 32)),
+		YCoord:        authenticator.Key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		authenticator.t.Fatalf("failed to encode public key: %v", err)
+	}
+
+	auth_data := authenticator.authData(flagUserPresent | flagUserVerified | flagAttestedData)
+	auth_data = append(auth_data, make([]byte, 16)...) //aaguid
+	auth_data = binary.BigEndian.AppendUint16(auth_data, uint16(len(authenticator.CredentialID)))
+	auth_data = append(auth_data, authenticator.CredentialID...)
+	auth_data = append(auth_data, public_key...)
+
+	attestation, err := webauthncbor.Marshal(map[string]interface{}{"fmt": "none", "attStmt": map[string]interface{}{}, "authData": auth_data})
+	if err != nil {
+		authenticator.t.Fatalf("failed to encode attestation: %v", err)
+	}
+
+	response, _ := json.Marshal(map[string]interface{}{
+		"id":    encode(authenticator.CredentialID),
+		"rawId": encode(authenticator.CredentialID),
+		"type":  "public-key",
+		"response": map[string]interface{}{
+			"clientDataJSON":    encode(clientData("webauthn.create", challenge)),
+			"attestationObject": encode(attestation),
+			"transports":        []string{"internal"},
+		},
+	})
+	return response
+}
+
+// Login returns PublicKeyCredential JSON for navigator.credentials.get signed with sign_count
+func (authenticator *soft_authenticator) Login(challenge string, sign_count uint32) []byte {
+	authenticator.SignCount = sign_count
+	auth_data := authenticator.authData(flagUserPresent | flagUserVerified)
+	client_data := clientData("webauthn.get", challenge)
+	client_data_hash := sha256.Sum256(client_data)
+
+	digest := sha256.Sum256(append(append([]byte{}, auth_data...), client_data_hash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, authenticator.Key, digest[:])
+	if err != nil {
+		authenticator.t.Fatalf("failed to sign assertion: %v", err)
+	}
+
+	response, _ := json.Marshal(map[string]interface{}{
+		"id":    encode(authenticator.CredentialID),
+		"rawId": encode(authenticator.CredentialID),
+		"type":  "public-key",
+		"response": map[string]interface{}{
+			"clientDataJSON":    encode(client_data),
+			"authenticatorData": encode(auth_data),
+			"signature":         encode(signature),
+			"userHandle":        encode(authenticator.UserHandle),
+		},
+	})
+	return response
+}
+
+func testPasskeyUser(guid string) *model.User {
+	email := guid + "@example.com"
+	return &model.User{GUID: guid, Email: &email}
+}
+
+// registerPasskey runs registration ceremony and returns stored credential
+func registerPasskey(t *testing.T, manager *passkey_manager, user *model.User, authenticator *soft_authenticator) *model.WebAuthnCredential {
+	t.Helper()
+	options, session, err := manager.BeginRegistration(user, nil)
+	if err != nil {
+		t.Fatalf("BeginRegistration() = %v", err)
+	}
+
+	credential, err := manager.FinishRegistration(user, nil, session, authenticator.Register(challengeOf(t, options), []byte(user.GUID)))
+	if err != nil {
+		t.Fatalf("FinishRegistration() = %v", err)
+	}
+
+	return credential
+}
+
+func TestPasskeyRegistration(t *testing.T) {
+	manager := testPasskeyManager(t)
+	user := testPasskeyUser("user-guid")
+	authenticator := newSoftAuthenticator(t)
+
+	credential := registerPasskey(t, manager, user, authenticator)
+	if credential.UserGUID != user.GUID || string(credential.CredentialID) != string(authenticator.CredentialID) {
+		t.Errorf("credential %+v doesn't belong to authenticator", credential)
+	}
+	if credential.Transports != "internal" || credential.AttestationType != "none" {
+		t.Errorf("transports %q, attestation %q", credential.Transports, credential.AttestationType)
+	}
+
+	tests := []struct {
+		name     string
+		user     *model.User
+		response func(challenge string) []byte
+		want     string
+	}{
+		{"wrong challenge", user, func(string) []byte {
+			return newSoftAuthenticator(t).Register(encode([]byte("wrong challenge of 32 bytes long")), []byte(user.GUID))
+		}, "challenge"},
+		{"session of another user", testPasskeyUser("other-guid"), func(challenge string) []byte {
+			return newSoftAuthenticator(t).Register(challenge, []byte(user.GUID))
+		}, "ID mismatch"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options, session, err := manager.BeginRegistration(user, nil)
+			if err != nil {
+				t.Fatalf("BeginRegistration() = %v", err)
+			}
+			_, err = manager.FinishRegistration(test.user, nil, session, test.response(challengeOf(t, options)))
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("FinishRegistration() = %v, want error with %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestPasskeyLogin(t *testing.T) {
+	manager := testPasskeyManager(t)
+	user := testPasskeyUser("user-guid")
+	authenticator := newSoftAuthenticator(t)
+	credential := registerPasskey(t, manager, user, authenticator)
+
+	other_user := testPasskeyUser("other-guid")
+	other_authenticator := newSoftAuthenticator(t)
+	other_credential := registerPasskey(t, manager, other_user, other_authenticator)
+
+	stored := map[string][]model.WebAuthnCredential{user.GUID: {*credential}, other_user.GUID: {*other_credential}}
+	users := map[string]*model.User{user.GUID: user, other_user.GUID: other_user}
+	lookup := func(user_handle []byte) (*model.User, []model.WebAuthnCredential, error) {
+		return users[string(user_handle)], stored[string(user_handle)], nil
+	}
+
+	login := func(response func(challenge string) []byte) (*model.User, *model.WebAuthnCredential, error) {
+		options, session, err := manager.BeginLogin()
+		if err != nil {
+			t.Fatalf("BeginLogin() = %v", err)
+		}
+		return manager.FinishLogin(session, response(challengeOf(t, options)), lookup)
+	}
+
+	logged_in, updated, err := login(func(challenge string) []byte { return authenticator.Login(challenge, 10) })
+	if err != nil {
+		t.Fatalf("FinishLogin() = %v", err)
+	}
+	if logged_in.GUID != user.GUID || updated.SignCount != 10 || updated.LastUsedAt == 0 {
+		t.Fatalf("FinishLogin() = %v, credential %+v", logged_in.GUID, updated)
+	}
+	stored[user.GUID] = []model.WebAuthnCredential{*updated}
+
+	tests := []struct {
+		name     string
+		response func(challenge string) []byte
+		want     string
+	}{
+		{"wrong challenge", func(string) []byte {
+			return authenticator.Login(encode([]byte("wrong challenge of 32 bytes long")), 11)
+		}, "challenge"},
+		{"sign count regression", func(challenge string) []byte {
+			return authenticator.Login(challenge, 3)
+		}, "cloned"},
+		{"sign count not grown", func(challenge string) []byte {
+			return authenticator.Login(challenge, 10)
+		}, "cloned"},
+		{"credential of another user", func(challenge string) []byte {
+			//other user's passkey presents handle of the victim
+			other_authenticator.UserHandle = []byte(user.GUID)
+			return other_authenticator.Login(challenge, 1)
+		}, "Unable to find the credential"},
+		{"unknown credential", func(challenge string) []byte {
+			unknown := newSoftAuthenticator(t)
+			unknown.UserHandle = []byte(user.GUID)
+			return unknown.Login(challenge, 1)
+		}, "Unable to find the credential"},
+		{"signed by another key", func(challenge string) []byte {
+			forged := newSoftAuthenticator(t)
+			forged.CredentialID = authenticator.CredentialID
+			forged.UserHandle = []byte(user.GUID)
+			return forged.Login(challenge, 20)
+		}, "signature"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, _, err := login(test.response); err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("FinishLogin() = %v, want error with %q", err, test.want)
+			}
+		})
+	}
+
+	if _, updated, err := login(func(challenge string) []byte { return authenticator.Login(challenge, 11) }); err != nil || updated.SignCount != 11 {
+		t.Fatalf("FinishLogin() after rejected attempts = %v", err)
+	}
+}
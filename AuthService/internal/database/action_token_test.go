@@ -0,0 +1,225 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/model"
+)
+
+func testUser(t *testing.T, db *postgres_db) string {
+	t.Helper()
+	email := uuid.New().String() + "@example.com"
+	guid, err := db.RegisterUser(&email, nil, "old hash")
+	if err != nil {
+		t.Fatalf("RegisterUser() = %v", err)
+	}
+
+	return guid
+}
+
+func TestResetPassword(t *testing.T) {
+	db := testDB(t)
+	manager := auth.NewActionTokenManager("pepper")
+	generator := testRefreshGenerator()
+	guid := testUser(t, db)
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := db.AddSession(guid, "", "", generator, 24*time.Hour, "agent", "127.0.0.1"); err != nil {
+			t.Fatalf("AddSession() = %v", err)
+		}
+	}
+	other_guid := testUser(t, db)
+	if _, _, err := db.AddSession(other_guid, "", "", generator, 24*time.Hour, "agent", "127.0.0.1"); err != nil {
+		t.Fatalf("AddSession() = %v", err)
+	}
+
+	action_token, token, err := manager.GenerateActionToken(auth.PasswordResetPurpose, guid, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateActionToken() = %v", err)
+	}
+	if err := db.AddActionToken(action_token); err != nil {
+		t.Fatalf("AddActionToken() = %v", err)
+	}
+	selector, _, _ := auth.SplitActionToken(token)
+	stored, err := db.SearchActionToken(auth.PasswordResetPurpose, selector)
+	if err != nil {
+		t.Fatalf("SearchActionToken() = %v", err)
+	}
+	if !manager.VerifyActionToken(token, stored) {
+		t.Fatalf("stored token is not verified")
+	}
+
+	sessions, err := db.ResetPassword(stored, "new hash")
+	if err != nil {
+		t.Fatalf("ResetPassword() = %v", err)
+	}
+	if len(sessions) != 3 {
+		t.Errorf("ResetPassword() returned %v sessions, want 3", len(sessions))
+	}
+	if count := countSessions(t, db, guid); count != 0 {
+		t.Errorf("user has %v sessions after reset, want 0", count)
+	}
+	if count := countSessions(t, db, other_guid); count != 1 {
+		t.Errorf("other user has %v sessions after reset, want 1", count)
+	}
+
+	user, err := db.GetUser(guid)
+	if err != nil {
+		t.Fatalf("GetUser() = %v", err)
+	}
+	if user.PasswordHash != "new hash" || !user.EmailVerified {
+		t.Errorf("user after reset: hash %q, email verified %v", user.PasswordHash, user.EmailVerified)
+	}
+
+	//token is single-use, also when the second request loaded it before the first one used it
+	if _, err := db.ResetPassword(stored, "another hash"); !errors.Is(err, ErrActionTokenUsed) {
+		t.Errorf("second ResetPassword() = %v, want %v", err, ErrActionTokenUsed)
+	}
+	used, err := db.SearchActionToken(auth.PasswordResetPurpose, selector)
+	if err != nil {
+		t.Fatalf("SearchActionToken() = %v", err)
+	}
+	if manager.VerifyActionToken(token, used) {
+		t.Errorf("used token is still verified")
+	}
+}
+
+func TestAddActionTokenReplacesUnused(t *testing.T) {
+	db := testDB(t)
+	manager := auth.NewActionTokenManager("pepper")
+	guid := testUser(t, db)
+
+	first, _, err := manager.GenerateActionToken(auth.PasswordResetPurpose, guid, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateActionToken() = %v", err)
+	}
+	if err := db.AddActionToken(first); err != nil {
+		t.Fatalf("AddActionToken() = %v", err)
+	}
+	second, _, err := manager.GenerateActionToken(auth.PasswordResetPurpose, guid, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateActionToken() = %v", err)
+	}
+	if err := db.AddActionToken(second); err != nil {
+		t.Fatalf("AddActionToken() = %v", err)
+	}
+
+	if _, err := db.SearchActionToken(auth.PasswordResetPurpose, first.Selector); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("previous token is still stored: %v", err)
+	}
+	if _, err := db.SearchActionToken(auth.PasswordResetPurpose, second.Selector); err != nil {
+		t.Errorf("latest token is not stored: %v", err)
+	}
+}
+
+func TestAddActionTokenKeepsAuthorizationCodes(t *testing.T) {
+	db := testDB(t)
+	manager := auth.NewActionTokenManager("pepper")
+	guid := testUser(t, db)
+
+	first, _, err := manager.GenerateActionToken(auth.OAuthCodePurpose, guid, time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateActionToken() = %v", err)
+	}
+	first.Data = `{"client_id":"web-app"}`
+	second, _, err := manager.GenerateActionToken(auth.OAuthCodePurpose, guid, time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateActionToken() = %v", err)
+	}
+	second.Data = `{"client_id":"mobile-app"}`
+	for _, action_token := range []*model.ActionToken{first, second} {
+		if err := db.AddActionToken(action_token); err != nil {
+			t.Fatalf("AddActionToken() = %v", err)
+		}
+	}
+
+	//code of one client doesn't break authorization of another one
+	for _, action_token := range []*model.ActionToken{first, second} {
+		if _, err := db.SearchActionToken(auth.OAuthCodePurpose, action_token.Selector); err != nil {
+			t.Errorf("code %v is not stored: %v", action_token.Selector, err)
+		}
+	}
+}
+
+func TestFailActionToken(t *testing.T) {
+	db := testDB(t)
+	manager := auth.NewActionTokenManager("pepper")
+	guid := testUser(t, db)
+	const max_attempts = 3
+
+	action_token, _, err := manager.GenerateActionCode(auth.PasswordlessLoginPurpose, guid, time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateActionCode() = %v", err)
+	}
+	if err := db.AddActionToken(action_token); err != nil {
+		t.Fatalf("AddActionToken() = %v", err)
+	}
+
+	for attempt := 1; attempt <= max_attempts; attempt++ {
+		if err := db.FailActionToken(action_token, max_attempts); err != nil {
+			t.Fatalf("FailActionToken() = %v", err)
+		}
+
+		stored, err := db.SearchActionToken(auth.PasswordlessLoginPurpose, action_token.Selector)
+		if err != nil {
+			t.Fatalf("SearchActionToken() = %v", err)
+		}
+		if stored.Attempts != attempt {
+			t.Errorf("attempts = %v, want %v", stored.Attempts, attempt)
+		}
+		if used := stored.UsedAt != 0; used != (attempt == max_attempts) {
+			t.Errorf("after %v attempts token used = %v", attempt, used)
+		}
+	}
+
+	//code is burned, even the right one can't be used anymore
+	if err := db.UsePasswordlessCode(action_token); !errors.Is(err, ErrActionTokenUsed) {
+		t.Errorf("UsePasswordlessCode() = %v, want %v", err, ErrActionTokenUsed)
+	}
+}
+
+func TestUsePasswordlessCode(t *testing.T) {
+	db := testDB(t)
+	manager := auth.NewActionTokenManager("pepper")
+	guid := testUser(t, db)
+
+	verification, _, err := manager.GenerateActionToken(auth.EmailVerificationPurpose, guid, time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateActionToken() = %v", err)
+	}
+	action_token, _, err := manager.GenerateActionCode(auth.PasswordlessLoginPurpose, guid, time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateActionCode() = %v", err)
+	}
+	for _, token := range []*model.ActionToken{verification, action_token} {
+		if err := db.AddActionToken(token); err != nil {
+			t.Fatalf("AddActionToken() = %v", err)
+		}
+	}
+
+	//token of another purpose is not a login code
+	if err := db.UsePasswordlessCode(verification); !errors.Is(err, ErrActionTokenUsed) {
+		t.Errorf("UsePasswordlessCode() of verification token = %v, want %v", err, ErrActionTokenUsed)
+	}
+
+	if err := db.UsePasswordlessCode(action_token); err != nil {
+		t.Fatalf("UsePasswordlessCode() = %v", err)
+	}
+	user, err := db.GetUser(guid)
+	if err != nil {
+		t.Fatalf("GetUser() = %v", err)
+	}
+	if !user.EmailVerified {
+		t.Errorf("email is not verified by login code")
+	}
+
+	if err := db.UsePasswordlessCode(action_token); !errors.Is(err, ErrActionTokenUsed) {
+		t.Errorf("second UsePasswordlessCode() = %v, want %v", err, ErrActionTokenUsed)
+	}
+}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"AuthService/internal/model"
+)
+
+// WithAdvisoryLock runs fn only if postgres advisory lock with key is free, so work is done by one replica at a time.
+// Lock belongs to db connection, that's why it is taken and released on one pinned connection.
+// false is returned when lock is held by someone else.
+func (db *postgres_db) WithAdvisoryLock(key int64, fn func() error) (bool, error) {
+	locked := false
+	err := db.PostgresDB.Connection(func(conn *gorm.DB) error {
+		if err := conn.Raw("SELECT pg_try_advisory_lock(?)", key).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		defer func() {
+			if err := conn.Exec("SELECT pg_advisory_unlock(?)", key).Error; err != nil {
+				log.Errorf("failed to release advisory lock %v: %v", key, err)
+			}
+		}()
+
+		return fn()
+	})
+	if err != nil {
+		log.Errorf("failed to run under advisory lock %v: %v", key, err)
+		return locked, err
+	}
+
+	return locked, nil
+}
+
+// userLockSpace is the first key of per-user advisory locks, two int keys don't overlap with bigint keys of WithAdvisoryLock
+const userLockSpace = 1
+
+// WithUserLock runs fn under advisory lock of user, it waits while the lock is held by another request of the user.
+// Lock is taken and released on one pinned connection like in WithAdvisoryLock.
+func (db *postgres_db) WithUserLock(guid string, fn func() error) error {
+	return db.PostgresDB.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?, hashtext(?))", userLockSpace, guid).Error; err != nil {
+			log.Errorf("failed to take advisory lock of user %v: %v", guid, err)
+			return err
+		}
+		defer func() {
+			if err := conn.Exec("SELECT pg_advisory_unlock(?, hashtext(?))", userLockSpace, guid).Error; err != nil {
+				log.Errorf("failed to release advisory lock of user %v: %v", guid, err)
+			}
+		}()
+
+		return fn()
+	})
+}
+
+// DeleteExpiredSessions deletes up to batch_size sessions expired before expired_before or not used since idle_before.
+// idle_before equal to zero disables idle check.
+func (db *postgres_db) DeleteExpiredSessions(expired_before int64, idle_before int64, batch_size int) (int64, error) {
+	expired := db.PostgresDB.Model(&model.Session{}).Select("id").Where("expires_at <= ?", expired_before)
+	if idle_before > 0 {
+		expired = expired.Or("last_used_at <= ?", idle_before)
+	}
+
+	result := db.PostgresDB.Where("id IN (?)", expired.Limit(batch_size)).Delete(&model.Session{})
+	if result.Error != nil {
+		log.Errorf("failed to delete expired sessions: %v", result.Error)
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// DeleteExpiredUsedRefresh deletes up to batch_size used refresh tokens, reuse of expired token can't be detected
+// anyway because its session family is expired too
+func (db *postgres_db) DeleteExpiredUsedRefresh(expired_before int64, batch_size int) (int64, error) {
+	expired := db.PostgresDB.Model(&model.UsedRefreshToken{}).Select("refresh_digest").Where("expires_at <= ?", expired_before).Limit(batch_size)
+
+	result := db.PostgresDB.Where("refresh_digest IN (?)", expired).Delete(&model.UsedRefreshToken{})
+	if result.Error != nil {
+		log.Errorf("failed to delete expired used refresh tokens: %v", result.Error)
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// DeleteExpiredActionTokens deletes up to batch_size expired action tokens, used or not
+func (db *postgres_db) DeleteExpiredActionTokens(expired_before int64, batch_size int) (int64, error) {
+	expired := db.PostgresDB.Model(&model.ActionToken{}).Select("id").Where("expires_at <= ?", expired_before).Limit(batch_size)
+
+	result := db.PostgresDB.Where("id IN (?)", expired).Delete(&model.ActionToken{})
+	if result.Error != nil {
+		log.Errorf("failed to delete expired action tokens: %v", result.Error)
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
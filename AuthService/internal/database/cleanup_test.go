@@ -0,0 +1,101 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"AuthService/internal/model"
+)
+
+func TestDeleteExpiredSessions(t *testing.T) {
+	db := testDB(t)
+	guid := uuid.New().String()
+	now := time.Now().Unix()
+
+	sessions := []model.Session{
+		{UserGUID: guid, CreatedAt: now - 7200, LastUsedAt: now - 60, ExpiresAt: now - 1},
+		{UserGUID: guid, CreatedAt: now - 7200, LastUsedAt: now - 7200, ExpiresAt: now + 3600},
+		{UserGUID: guid, CreatedAt: now - 7200, LastUsedAt: now - 60, ExpiresAt: now + 3600},
+	}
+	for i := range sessions {
+		if err := db.PostgresDB.Create(&sessions[i]).Error; err != nil {
+			t.Fatalf("failed to create session: %v", err)
+		}
+	}
+
+	//without idle check only the session after absolute expiry is deleted
+	for {
+		deleted, err := db.DeleteExpiredSessions(now, 0, 1)
+		if err != nil {
+			t.Fatalf("DeleteExpiredSessions() = %v", err)
+		}
+		if deleted > 1 {
+			t.Fatalf("DeleteExpiredSessions() deleted %v sessions, batch size is 1", deleted)
+		}
+		if deleted == 0 {
+			break
+		}
+	}
+	if count := countSessions(t, db, guid); count != 2 {
+		t.Fatalf("user has %v sessions, want 2", count)
+	}
+
+	for {
+		deleted, err := db.DeleteExpiredSessions(now, now-3600, 100)
+		if err != nil {
+			t.Fatalf("DeleteExpiredSessions() = %v", err)
+		}
+		if deleted < 100 {
+			break
+		}
+	}
+	if _, err := db.SearchSession(guid, sessions[2].ID); err != nil || countSessions(t, db, guid) != 1 {
+		t.Errorf("only active session has to be left: %v", err)
+	}
+}
+
+func TestDeleteExpiredTokens(t *testing.T) {
+	db := testDB(t)
+	now := time.Now().Unix()
+
+	expired_used := model.UsedRefreshToken{RefreshDigest: uuid.New().String(), FamilyID: uuid.New().String(), ExpiresAt: now - 1}
+	active_used := model.UsedRefreshToken{RefreshDigest: uuid.New().String(), FamilyID: uuid.New().String(), ExpiresAt: now + 3600}
+	expired_action := model.ActionToken{Purpose: "test", Selector: uuid.New().String(), ExpiresAt: now - 1}
+	active_action := model.ActionToken{Purpose: "test", Selector: uuid.New().String(), ExpiresAt: now + 3600}
+	for _, row := range []interface{}{&expired_used, &active_used, &expired_action, &active_action} {
+		if err := db.PostgresDB.Create(row).Error; err != nil {
+			t.Fatalf("failed to create row: %v", err)
+		}
+	}
+
+	for {
+		deleted, err := db.DeleteExpiredUsedRefresh(now, 100)
+		if err != nil {
+			t.Fatalf("DeleteExpiredUsedRefresh() = %v", err)
+		}
+		if deleted < 100 {
+			break
+		}
+	}
+	for {
+		deleted, err := db.DeleteExpiredActionTokens(now, 100)
+		if err != nil {
+			t.Fatalf("DeleteExpiredActionTokens() = %v", err)
+		}
+		if deleted < 100 {
+			break
+		}
+	}
+
+	var count int64
+	db.PostgresDB.Model(&model.UsedRefreshToken{}).Where("refresh_digest IN ?", []string{expired_used.RefreshDigest, active_used.RefreshDigest}).Count(&count)
+	if count != 1 {
+		t.Errorf("%v used refresh tokens left, want 1", count)
+	}
+	db.PostgresDB.Model(&model.ActionToken{}).Where("id IN ?", []uint{expired_action.ID, active_action.ID}).Count(&count)
+	if count != 1 {
+		t.Errorf("%v action tokens left, want 1", count)
+	}
+}
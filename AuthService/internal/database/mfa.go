@@ -0,0 +1,132 @@
+package database
+
+import (
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"AuthService/internal/model"
+)
+
+func (db *postgres_db) UseActionToken(action_token *model.ActionToken) error {
+	if err := useActionToken(&db.PostgresDB, action_token); err != nil {
+		if !errors.Is(err, ErrActionTokenUsed) {
+			log.Errorf("failed to use action token: %v", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// FailActionToken counts wrong code entered for token, after max_attempts token is marked used
+func (db *postgres_db) FailActionToken(action_token *model.ActionToken, max_attempts int) error {
+	err := db.PostgresDB.Model(&model.ActionToken{}).Where("id = ? AND used_at = 0", action_token.ID).Updates(map[string]interface{}{
+		"attempts": gorm.Expr("attempts + 1"),
+		"used_at":  gorm.Expr("CASE WHEN attempts + 1 >= ? THEN ? ELSE used_at END", max_attempts, time.Now().Unix()),
+	}).Error
+	if err != nil {
+		log.Errorf("failed to count action token attempt: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// SaveTOTP replaces not yet confirmed secret of user, enabled TOTP is never replaced
+func (db *postgres_db) SaveTOTP(guid string, encrypted_secret string) error {
+	err := db.PostgresDB.Transaction(func(tx *gorm.DB) error {
+		credential := model.TOTPCredential{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_guid = ?", guid).First(&credential).Error
+		if err == nil && credential.Enabled {
+			return ErrTOTPEnabled
+		}
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		return tx.Save(&model.TOTPCredential{UserGUID: guid, Secret: encrypted_secret, CreatedAt: time.Now().Unix()}).Error
+	})
+	if err != nil {
+		if !errors.Is(err, ErrTOTPEnabled) {
+			log.Errorf("failed to save totp secret: %v", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (db *postgres_db) GetTOTP(guid string) (*model.TOTPCredential, error) {
+	credential := model.TOTPCredential{}
+	if err := db.PostgresDB.Where("user_guid = ?", guid).First(&credential).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("failed to find totp: %v", err)
+		}
+		return nil, err
+	}
+
+	return &credential, nil
+}
+
+// EnableTOTP enables confirmed secret and replaces recovery codes of user. Step of confirmation code
+// is saved as the last used one.
+func (db *postgres_db) EnableTOTP(guid string, step int64, recovery_hashes []string) error {
+	err := db.PostgresDB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.TOTPCredential{}).Where("user_guid = ? AND enabled = false", guid).Updates(map[string]interface{}{"enabled": true, "last_step": step})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrTOTPEnabled
+		}
+
+		if err := tx.Where("user_guid = ?", guid).Delete(&model.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+
+		codes := make([]model.RecoveryCode, 0, len(recovery_hashes))
+		for _, hash := range recovery_hashes {
+			codes = append(codes, model.RecoveryCode{UserGUID: guid, CodeHash: hash})
+		}
+		return tx.Create(&codes).Error
+	})
+	if err != nil {
+		if !errors.Is(err, ErrTOTPEnabled) {
+			log.Errorf("failed to enable totp: %v", err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UseTOTPStep moves last used step forward, ErrTOTPReplay means code of this or later step was already accepted
+func (db *postgres_db) UseTOTPStep(guid string, step int64) error {
+	result := db.PostgresDB.Model(&model.TOTPCredential{}).Where("user_guid = ? AND enabled = true AND last_step < ?", guid, step).Update("last_step", step)
+	if result.Error != nil {
+		log.Errorf("failed to update totp step: %v", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTOTPReplay
+	}
+
+	return nil
+}
+
+func (db *postgres_db) UseRecoveryCode(guid string, code_hash string) error {
+	result := db.PostgresDB.Model(&model.RecoveryCode{}).Where("user_guid = ? AND code_hash = ? AND used_at = 0", guid, code_hash).Update("used_at", time.Now().Unix())
+	if result.Error != nil {
+		log.Errorf("failed to use recovery code: %v", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRecoveryCode
+	}
+
+	return nil
+}
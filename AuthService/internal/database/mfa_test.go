@@ -0,0 +1,73 @@
+package database
+
+import (
+	"errors"
+	"testing"
+)
+
+func enableTestTOTP(t *testing.T, db *postgres_db, recovery_hashes []string) string {
+	t.Helper()
+	guid := testUser(t, db)
+	if err := db.SaveTOTP(guid, "encrypted secret"); err != nil {
+		t.Fatalf("SaveTOTP() = %v", err)
+	}
+	if err := db.EnableTOTP(guid, 100, recovery_hashes); err != nil {
+		t.Fatalf("EnableTOTP() = %v", err)
+	}
+
+	return guid
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	db := testDB(t)
+	guid := enableTestTOTP(t, db, []string{"first", "second"})
+	other_guid := enableTestTOTP(t, db, []string{"other"})
+
+	tests := []struct {
+		name string
+		guid string
+		hash string
+		want error
+	}{
+		{"unused code", guid, "first", nil},
+		{"code is consumed", guid, "first", ErrRecoveryCode},
+		{"another code still works", guid, "second", nil},
+		{"unknown code", guid, "unknown", ErrRecoveryCode},
+		{"code of other user", guid, "other", ErrRecoveryCode},
+		{"other user keeps code", other_guid, "other", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := db.UseRecoveryCode(test.guid, test.hash); !errors.Is(err, test.want) {
+				t.Fatalf("UseRecoveryCode() = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestUseTOTPStep(t *testing.T) {
+	db := testDB(t)
+	guid := enableTestTOTP(t, db, []string{"code"})
+
+	//step of confirmation code is already used
+	tests := []struct {
+		name string
+		step int64
+		want error
+	}{
+		{"confirmation step", 100, ErrTOTPReplay},
+		{"next step", 101, nil},
+		{"replay", 101, ErrTOTPReplay},
+		{"older step within skew", 100, ErrTOTPReplay},
+		{"later step", 103, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := db.UseTOTPStep(guid, test.step); !errors.Is(err, test.want) {
+				t.Fatalf("UseTOTPStep(%v) = %v, want %v", test.step, err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package database
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"AuthService/internal/model"
+)
+
+// legacyExpiresAt separates unix seconds from expires_at of sessions created before absolute and idle
+// expiry, these were saved as seconds plus lifetime in nanoseconds and are far beyond any real date
+const legacyExpiresAt = int64(100_000_000_000)
+
+// MigrateLegacySessions normalizes sessions created before absolute and idle expiry. Their expires_at is
+// recalculated in seconds from exparation_time, login time is restored from it, and last_used_at is set to
+// now, so legacy session is not rejected as idle and its refresh token can be rotated once more.
+// Migration is idempotent and is run on every start.
+func (db *postgres_db) MigrateLegacySessions(exparation_time time.Duration) error {
+	now := time.Now().Unix()
+	err := db.PostgresDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Session{}).Where("expires_at > ?", legacyExpiresAt).
+			Update("created_at", gorm.Expr("LEAST(GREATEST(expires_at - ?, 0), ?)", int64(exparation_time), now)).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&model.Session{}).Where("expires_at > ?", legacyExpiresAt).
+			Update("expires_at", gorm.Expr("created_at + ?", int64(exparation_time/time.Second))).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&model.Session{}).Where("COALESCE(last_used_at, 0) = 0").Update("last_used_at", now).Error
+	})
+	if err != nil {
+		log.Errorf("failed to migrate legacy sessions: %v", err)
+		return err
+	}
+
+	return nil
+}
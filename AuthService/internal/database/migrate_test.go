@@ -0,0 +1,56 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"AuthService/internal/model"
+)
+
+func TestMigrateLegacySessions(t *testing.T) {
+	db := testDB(t)
+	exparation_time := 24 * time.Hour
+	login := time.Now().Add(-time.Hour).Unix()
+
+	//expires_at as it was saved before absolute and idle expiry: seconds plus lifetime in nanoseconds
+	legacy := model.Session{UserGUID: uuid.New().String(), Refresh: "$2a$legacy", ExpiresAt: login + int64(exparation_time)}
+	if err := db.PostgresDB.Create(&legacy).Error; err != nil {
+		t.Fatalf("failed to create legacy session: %v", err)
+	}
+	selector := uuid.New().String()
+	current := model.Session{UserGUID: legacy.UserGUID, Selector: &selector, CreatedAt: login, LastUsedAt: login, ExpiresAt: login + 3600}
+	if err := db.PostgresDB.Create(&current).Error; err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	//second run must not change anything
+	for i := 0; i < 2; i++ {
+		if err := db.MigrateLegacySessions(exparation_time); err != nil {
+			t.Fatalf("MigrateLegacySessions() = %v", err)
+		}
+	}
+
+	migrated, err := db.SearchSession(legacy.UserGUID, legacy.ID)
+	if err != nil {
+		t.Fatalf("failed to find legacy session: %v", err)
+	}
+	if migrated.CreatedAt != login {
+		t.Errorf("created_at = %v, want %v", migrated.CreatedAt, login)
+	}
+	if want := login + int64(exparation_time/time.Second); migrated.ExpiresAt != want {
+		t.Errorf("expires_at = %v, want %v", migrated.ExpiresAt, want)
+	}
+	if now := time.Now().Unix(); migrated.LastUsedAt < now-60 || migrated.LastUsedAt > now {
+		t.Errorf("last_used_at = %v, want about %v", migrated.LastUsedAt, now)
+	}
+
+	untouched, err := db.SearchSession(current.UserGUID, current.ID)
+	if err != nil {
+		t.Fatalf("failed to find session: %v", err)
+	}
+	if untouched.CreatedAt != current.CreatedAt || untouched.LastUsedAt != current.LastUsedAt || untouched.ExpiresAt != current.ExpiresAt {
+		t.Errorf("session with seconds changed: %+v", untouched)
+	}
+}
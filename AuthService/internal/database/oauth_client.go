@@ -0,0 +1,79 @@
+package database
+
+import (
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"AuthService/internal/model"
+)
+
+func (db *postgres_db) AddClient(client *model.OAuthClient) error {
+	if err := db.PostgresDB.Create(client).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrClientExists
+		}
+		log.Errorf("failed to add oauth client: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (db *postgres_db) GetClient(client_id string) (*model.OAuthClient, error) {
+	client := model.OAuthClient{}
+	if err := db.PostgresDB.Where("id = ?", client_id).First(&client).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("failed to find oauth client: %v", err)
+		}
+		return nil, err
+	}
+
+	return &client, nil
+}
+
+// UpdateClient replaces settings of client, secret and disabled flag are changed by their own methods
+func (db *postgres_db) UpdateClient(client *model.OAuthClient) error {
+	return db.updateClient(client.ID, map[string]interface{}{
+		"grant_types":       client.GrantTypes,
+		"redirect_uris":     client.RedirectURIs,
+		"scopes":            client.Scopes,
+		"access_life_time":  client.AccessLifeTime,
+		"refresh_life_time": client.RefreshLifeTime,
+	})
+}
+
+func (db *postgres_db) UpdateClientSecret(client_id string, secret_hash string) error {
+	return db.updateClient(client_id, map[string]interface{}{"secret_hash": secret_hash})
+}
+
+func (db *postgres_db) DisableClient(client_id string) error {
+	return db.updateClient(client_id, map[string]interface{}{"disabled": true})
+}
+
+// MaxAccessLifeTime returns the longest access token lifetime set for clients, 0 if none overrides it
+func (db *postgres_db) MaxAccessLifeTime() (time.Duration, error) {
+	var max_life_time int64
+	if err := db.PostgresDB.Model(&model.OAuthClient{}).Select("COALESCE(MAX(access_life_time), 0)").Scan(&max_life_time).Error; err != nil {
+		log.Errorf("failed to find max access life time: %v", err)
+		return 0, err
+	}
+
+	return time.Duration(max_life_time) * time.Second, nil
+}
+
+func (db *postgres_db) updateClient(client_id string, values map[string]interface{}) error {
+	values["updated_at"] = time.Now().Unix()
+	result := db.PostgresDB.Model(&model.OAuthClient{}).Where("id = ?", client_id).Updates(values)
+	if result.Error != nil {
+		log.Errorf("failed to update oauth client: %v", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
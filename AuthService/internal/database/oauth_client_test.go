@@ -0,0 +1,86 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"AuthService/internal/model"
+)
+
+func TestClientRegistry(t *testing.T) {
+	db := testDB(t)
+	client_id := "client-" + uuid.NewString()
+
+	client := &model.OAuthClient{ID: client_id, SecretHash: "hash", GrantTypes: "authorization_code", RedirectURIs: "https://app.example.com/callback", AccessLifeTime: 300}
+	if err := db.AddClient(client); err != nil {
+		t.Fatalf("AddClient() = %v", err)
+	}
+	if err := db.AddClient(&model.OAuthClient{ID: client_id}); !errors.Is(err, ErrClientExists) {
+		t.Errorf("second AddClient() = %v, want %v", err, ErrClientExists)
+	}
+
+	//settings are replaced, secret and disabled flag are kept
+	err := db.UpdateClient(&model.OAuthClient{ID: client_id, GrantTypes: "refresh_token", Scopes: "openid", Disabled: true})
+	if err != nil {
+		t.Fatalf("UpdateClient() = %v", err)
+	}
+	stored, err := db.GetClient(client_id)
+	if err != nil {
+		t.Fatalf("GetClient() = %v", err)
+	}
+	if stored.GrantTypes != "refresh_token" || stored.RedirectURIs != "" || stored.Scopes != "openid" || stored.AccessLifeTime != 0 {
+		t.Errorf("UpdateClient() stored %+v", stored)
+	}
+	if stored.SecretHash != "hash" || stored.Disabled || stored.UpdatedAt == 0 {
+		t.Errorf("secret %v, disabled %v, updated at %v", stored.SecretHash, stored.Disabled, stored.UpdatedAt)
+	}
+
+	if err := db.UpdateClientSecret(client_id, "new hash"); err != nil {
+		t.Fatalf("UpdateClientSecret() = %v", err)
+	}
+	if err := db.DisableClient(client_id); err != nil {
+		t.Fatalf("DisableClient() = %v", err)
+	}
+	stored, err = db.GetClient(client_id)
+	if err != nil {
+		t.Fatalf("GetClient() = %v", err)
+	}
+	if stored.SecretHash != "new hash" || !stored.Disabled {
+		t.Errorf("secret %v, disabled %v", stored.SecretHash, stored.Disabled)
+	}
+
+	unknown := "client-" + uuid.NewString()
+	if _, err := db.GetClient(unknown); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetClient() of unknown client = %v", err)
+	}
+	for name, update := range map[string]func() error{
+		"UpdateClient":       func() error { return db.UpdateClient(&model.OAuthClient{ID: unknown}) },
+		"UpdateClientSecret": func() error { return db.UpdateClientSecret(unknown, "hash") },
+		"DisableClient":      func() error { return db.DisableClient(unknown) },
+	} {
+		if err := update(); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("%v() of unknown client = %v, want %v", name, err, gorm.ErrRecordNotFound)
+		}
+	}
+}
+
+func TestMaxAccessLifeTime(t *testing.T) {
+	db := testDB(t)
+	client := &model.OAuthClient{ID: "client-" + uuid.NewString(), GrantTypes: "refresh_token", AccessLifeTime: int64(100 * 24 * time.Hour / time.Second)}
+	if err := db.AddClient(client); err != nil {
+		t.Fatalf("AddClient() = %v", err)
+	}
+
+	//the table is shared with other tests, so the longest lifetime is at least this client's one
+	max_life_time, err := db.MaxAccessLifeTime()
+	if err != nil {
+		t.Fatalf("MaxAccessLifeTime() = %v", err)
+	}
+	if max_life_time < 100*24*time.Hour {
+		t.Errorf("MaxAccessLifeTime() = %v", max_life_time)
+	}
+}
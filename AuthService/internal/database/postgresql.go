@@ -1,12 +1,15 @@
 package database
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"github.com/google/uuid"
 
 	"AuthService/internal/model"
@@ -17,11 +20,57 @@ import (
 type Database interface {
 	SearchGUID(guid string) error
 	SearchSession(guid string, session_id uint) (*model.Session, error)
+	SearchSessionByRefresh(refresh string) (*model.Session, error)
 	DeleteSession(guid string, session_id uint) error
-	AddSession(guid string, refresh_generator auth.RefreshManager, user_agent string, user_ip string) (uint, string, error)
+	ListSessions(guid string) ([]model.Session, error)
+	AddSession(guid string, client_id string, scope string, refresh_generator auth.RefreshManager, life_time time.Duration, user_agent string, user_ip string) (uint, string, error)
+	RotateSession(old *model.Session, refresh string, refresh_generator auth.RefreshManager, life_time time.Duration, user_agent string, user_ip string) (uint, string, error)
+	SearchUsedRefresh(refresh string) (*model.UsedRefreshToken, error)
+	DeleteSessionFamily(family_id string) ([]model.Session, error)
 	AddUser() (string, error)
+	RegisterUser(email *string, username *string, password_hash string) (string, error)
+	SearchUserByIdentifier(identifier string) (*model.User, error)
+	UpdatePasswordHash(guid string, password_hash string) error
+	GetUser(guid string) (*model.User, error)
+	AddActionToken(action_token *model.ActionToken) error
+	SearchActionToken(purpose string, selector string) (*model.ActionToken, error)
+	ResetPassword(action_token *model.ActionToken, password_hash string) ([]model.Session, error)
+	VerifyEmail(action_token *model.ActionToken) error
+	UsePasswordlessCode(action_token *model.ActionToken) error
+	UseActionToken(action_token *model.ActionToken) error
+	FailActionToken(action_token *model.ActionToken, max_attempts int) error
+	SaveTOTP(guid string, encrypted_secret string) error
+	GetTOTP(guid string) (*model.TOTPCredential, error)
+	EnableTOTP(guid string, step int64, recovery_hashes []string) error
+	UseTOTPStep(guid string, step int64) error
+	UseRecoveryCode(guid string, code_hash string) error
+	AddWebAuthnCredential(credential *model.WebAuthnCredential) error
+	ListWebAuthnCredentials(guid string) ([]model.WebAuthnCredential, error)
+	UpdateWebAuthnCredential(credential *model.WebAuthnCredential) error
+	AddClient(client *model.OAuthClient) error
+	GetClient(client_id string) (*model.OAuthClient, error)
+	UpdateClient(client *model.OAuthClient) error
+	UpdateClientSecret(client_id string, secret_hash string) error
+	DisableClient(client_id string) error
+	MaxAccessLifeTime() (time.Duration, error)
+	WithAdvisoryLock(key int64, fn func() error) (bool, error)
+	WithUserLock(guid string, fn func() error) error
+	DeleteExpiredSessions(expired_before int64, idle_before int64, batch_size int) (int64, error)
+	DeleteExpiredUsedRefresh(expired_before int64, batch_size int) (int64, error)
+	DeleteExpiredActionTokens(expired_before int64, batch_size int) (int64, error)
 }
 
+var (
+	ErrRefreshMismatch = errors.New("refresh token does not match session")
+	ErrUserExists      = errors.New("user with such email or username already exists")
+	ErrActionTokenUsed = errors.New("action token is already used")
+	ErrTOTPEnabled     = errors.New("totp is already enabled")
+	ErrTOTPReplay      = errors.New("totp code is already used")
+	ErrRecoveryCode    = errors.New("recovery code is invalid or used")
+	ErrPasskeyExists   = errors.New("passkey is already registered")
+	ErrClientExists    = errors.New("oauth client already exists")
+)
+
 type postgres_db struct {
 	PostgresDB gorm.DB
 }
@@ -38,14 +87,27 @@ func InitDataBase() *gorm.DB {
 	time.Sleep(5 * time.Second)
 	log.Info("Sleeping")
 	dsn := fmt.Sprintf("host=%v user=%v password=%v dbname=%v port=%v sslmode=%v TimeZone=%v", host, user, password, db_name, port, ssl_mode, timezone)
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Errorf("Failed to init DB: %v", err)
 	}
-	db.AutoMigrate(&model.User{}, &model.Session{})
+	if err := migrate(db); err != nil {
+		log.Errorf("Failed to migrate DB: %v", err)
+	}
 	return db
 }
 
+func migrate(db *gorm.DB) error {
+	//selector was saved as empty string for legacy sessions, unique index allows only NULLs to repeat
+	if db.Migrator().HasColumn(&model.Session{}, "Selector") {
+		if err := db.Model(&model.Session{}).Where("selector = ''").Update("selector", nil).Error; err != nil {
+			return err
+		}
+	}
+
+	return db.AutoMigrate(&model.User{}, &model.Session{}, &model.UsedRefreshToken{}, &model.ActionToken{}, &model.TOTPCredential{}, &model.RecoveryCode{}, &model.WebAuthnCredential{}, &model.OAuthClient{})
+}
+
 func NewPostgresDB(db *gorm.DB) *postgres_db {
 	postgres := postgres_db{PostgresDB: *db}
 	return &postgres
@@ -63,6 +125,52 @@ func (db *postgres_db) AddUser() (string, error){
 	return guid, nil
 }
 
+// RegisterUser creates user with credentials, identifiers must be already normalized
+func (db *postgres_db) RegisterUser(email *string, username *string, password_hash string) (string, error) {
+	user := model.User{GUID: uuid.New().String(), Email: email, Username: username, PasswordHash: password_hash}
+	if err := db.PostgresDB.Create(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return "", ErrUserExists
+		}
+		log.Errorf("failed to register user: %v", err)
+		return "", err
+	}
+
+	return user.GUID, nil
+}
+
+// SearchUserByIdentifier finds user by normalized email or username
+func (db *postgres_db) SearchUserByIdentifier(identifier string) (*model.User, error) {
+	user := model.User{}
+	if err := db.PostgresDB.Where("email = ? OR username = ?", identifier, identifier).First(&user).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("failed to find user: %v", err)
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (db *postgres_db) UpdatePasswordHash(guid string, password_hash string) error {
+	if err := db.PostgresDB.Model(&model.User{}).Where("guid = ?", guid).Update("password_hash", password_hash).Error; err != nil {
+		log.Errorf("failed to update password hash: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (db *postgres_db) GetUser(guid string) (*model.User, error) {
+	user := model.User{}
+	if err := db.PostgresDB.Where("guid = ?", guid).First(&user).Error; err != nil {
+		log.Errorf("failed to find user: %v", err)
+		return nil, err
+	}
+
+	return &user, nil
+}
+
 func (db *postgres_db) SearchGUID(guid string) error {
 	target_guid := &model.User{}
 	if err := db.PostgresDB.Where("guid = ?", guid).First(&target_guid).Error; err != nil {
@@ -73,27 +181,26 @@ func (db *postgres_db) SearchGUID(guid string) error {
 	return nil
 }
 
-func (db *postgres_db) AddSession(guid string, refresh_generator auth.RefreshManager, user_agent string, user_ip string) (uint, string, error) {
+// AddSession creates session of client, life_time is its absolute lifetime (global or of client)
+func (db *postgres_db) AddSession(guid string, client_id string, scope string, refresh_generator auth.RefreshManager, life_time time.Duration, user_agent string, user_ip string) (uint, string, error) {
+	now := time.Now()
 	session := model.Session{
-		UserGUID:  guid,
-		ExpiresAt: time.Now().Unix() + int64(refresh_generator.GetExparationTime()),
-		UserIP:    user_ip,
-		UserAgent: user_agent,
+		UserGUID:   guid,
+		FamilyID:   uuid.New().String(),
+		CreatedAt:  now.Unix(),
+		LastUsedAt: now.Unix(),
+		ExpiresAt:  now.Add(life_time).Unix(),
+		UserIP:     user_ip,
+		UserAgent:  user_agent,
+		ClientID:   client_id,
+		Scope:      scope,
 	}
 
-	refresh, err := refresh_generator.GenerateRefreshToken()
-	if err != nil {
-		log.Errorf("failed to generate refresh: %v", err)
-		return 0, "", err
-	}
-	refresh_hash, err := utils.GenerateHash(refresh)
+	refresh, err := setRefresh(&session, refresh_generator)
 	if err != nil {
-		log.Errorf("failed to generate hash: %v", err)
 		return 0, "", err
 	}
 
-	session.Refresh = refresh_hash
-
 	result := db.PostgresDB.Create(&session)
 	if result.Error != nil {
 		log.Errorf("failed to create session: %v", result.Error)
@@ -103,9 +210,126 @@ func (db *postgres_db) AddSession(guid string, refresh_generator auth.RefreshMan
 	return session.ID, refresh, nil
 }
 
+// RotateSession replaces session with its child from the same family. Session row is locked with
+// SELECT ... FOR UPDATE and refresh is verified again inside the transaction, so from concurrent
+// refreshes with one token only the first one wins, others get gorm.ErrRecordNotFound.
+// Refresh token of replaced session is remembered, so its reuse can be detected.
+func (db *postgres_db) RotateSession(old *model.Session, refresh string, refresh_generator auth.RefreshManager, life_time time.Duration, user_agent string, user_ip string) (uint, string, error) {
+	session := model.Session{}
+	new_refresh := ""
+	err := db.PostgresDB.Transaction(func(tx *gorm.DB) error {
+		locked := model.Session{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_guid = ? AND id = ?", old.UserGUID, old.ID).First(&locked).Error
+		if err != nil {
+			return err
+		}
+		if !refresh_generator.VerifyRefresh(refresh, &locked) {
+			return ErrRefreshMismatch
+		}
+
+		session = childSession(&locked, life_time, user_agent, user_ip)
+		new_refresh, err = setRefresh(&session, refresh_generator)
+		if err != nil {
+			return err
+		}
+
+		//legacy session has no digest, but its token is known here, so its reuse is detected as well
+		refresh_digest := locked.RefreshDigest
+		if refresh_digest == "" {
+			refresh_digest = utils.HashToken(refresh)
+		}
+		used := model.UsedRefreshToken{
+			RefreshDigest: refresh_digest,
+			FamilyID:      session.FamilyID,
+			UserGUID:      locked.UserGUID,
+			SessionID:     locked.ID,
+			ExpiresAt:     locked.ExpiresAt,
+		}
+		if err := tx.Create(&used).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&locked).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&session).Error
+	})
+	if err != nil {
+		log.Errorf("failed to rotate session %v: %v", old.ID, err)
+		return 0, "", err
+	}
+
+	return session.ID, new_refresh, nil
+}
+
+// childSession inherits family and absolute expiry of parent, so refreshes can't prolong session family forever
+func childSession(parent *model.Session, life_time time.Duration, user_agent string, user_ip string) model.Session {
+	family_id := parent.FamilyID
+	if family_id == "" {
+		family_id = uuid.New().String()
+	}
+
+	now := time.Now()
+	expires_at := now.Add(life_time).Unix()
+	if parent.ExpiresAt < expires_at {
+		expires_at = parent.ExpiresAt
+	}
+	created_at := parent.CreatedAt
+	if created_at == 0 {
+		created_at = now.Unix()
+	}
+
+	return model.Session{
+		UserGUID:   parent.UserGUID,
+		FamilyID:   family_id,
+		ParentID:   parent.ID,
+		CreatedAt:  created_at,
+		LastUsedAt: now.Unix(),
+		ExpiresAt:  expires_at,
+		UserIP:     user_ip,
+		UserAgent:  user_agent,
+		ClientID:   parent.ClientID,
+		Scope:      parent.Scope,
+	}
+}
+
+func (db *postgres_db) SearchUsedRefresh(refresh string) (*model.UsedRefreshToken, error) {
+	used := model.UsedRefreshToken{}
+	if err := db.PostgresDB.Where("refresh_digest = ?", utils.HashToken(refresh)).First(&used).Error; err != nil {
+		return nil, err
+	}
+
+	return &used, nil
+}
+
+// DeleteSessionFamily deletes every session created by rotations of one login and returns deleted sessions
+func (db *postgres_db) DeleteSessionFamily(family_id string) ([]model.Session, error) {
+	sessions := []model.Session{}
+	if err := db.PostgresDB.Clauses(clause.Returning{}).Where("family_id = ?", family_id).Delete(&sessions).Error; err != nil {
+		log.Errorf("failed to delete session family: %v", err)
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func setRefresh(session *model.Session, refresh_generator auth.RefreshManager) (string, error) {
+	refresh, err := refresh_generator.GenerateRefreshToken()
+	if err != nil {
+		log.Errorf("failed to generate refresh: %v", err)
+		return "", err
+	}
+
+	session.Selector = &refresh.Selector
+	session.Refresh = refresh_generator.HashVerifier(refresh.Verifier)
+	session.RefreshDigest = utils.HashToken(refresh.String())
+	return refresh.String(), nil
+}
+
 func (db *postgres_db) SearchSession(guid string, session_id uint) (*model.Session, error) {
 	session := model.Session{}
-	err := db.PostgresDB.Where("user_guid = ? AND id = ?", guid, session_id).Find(&session).Error
+	err := db.PostgresDB.Where("user_guid = ? AND id = ?", guid, session_id).First(&session).Error
 	if err != nil {
 		log.Errorf("Failed to find session: %v", err)
 		return nil, err
@@ -114,6 +338,68 @@ func (db *postgres_db) SearchSession(guid string, session_id uint) (*model.Sessi
 	return &session, nil
 }
 
+// SearchSessionByRefresh finds session by selector of refresh token, legacy tokens are found by digest.
+// Token itself is not checked here, caller has to verify it with RefreshManager.VerifyRefresh
+func (db *postgres_db) SearchSessionByRefresh(refresh string) (*model.Session, error) {
+	session := model.Session{}
+	query := db.PostgresDB.Where("refresh_digest = ?", utils.HashToken(refresh))
+	token, parse_err := auth.ParseRefreshToken(refresh)
+	if parse_err == nil {
+		query = db.PostgresDB.Where("selector = ?", token.Selector)
+	}
+
+	err := query.First(&session).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) && parse_err != nil {
+		return db.searchLegacyRefresh(refresh)
+	}
+	if err != nil {
+		log.Errorf("Failed to find session by refresh: %v", err)
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// searchLegacyRefresh finds session created before refresh digests, it has only bcrypt hash of the token.
+// Hashes of such sessions are compared one by one, and digest of the found token is saved, so the next
+// lookup is done by digest. Tokens of that time are base64 strings, anything else is not searched.
+func (db *postgres_db) searchLegacyRefresh(refresh string) (*model.Session, error) {
+	if _, err := base64.StdEncoding.DecodeString(refresh); err != nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	sessions := []model.Session{}
+	err := db.PostgresDB.Where("selector IS NULL AND COALESCE(refresh_digest, '') = '' AND expires_at > ?", time.Now().Unix()).Find(&sessions).Error
+	if err != nil {
+		log.Errorf("failed to search legacy sessions: %v", err)
+		return nil, err
+	}
+
+	for _, session := range sessions {
+		if utils.CompareHashAndPassword(refresh, session.Refresh) != nil {
+			continue
+		}
+		if err := db.PostgresDB.Model(&session).Update("refresh_digest", utils.HashToken(refresh)).Error; err != nil {
+			log.Errorf("failed to save refresh digest of legacy session %v: %v", session.ID, err)
+		}
+		return &session, nil
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ListSessions returns sessions of user which are not expired yet, the most recently used first
+func (db *postgres_db) ListSessions(guid string) ([]model.Session, error) {
+	sessions := []model.Session{}
+	err := db.PostgresDB.Where("user_guid = ? AND expires_at > ?", guid, time.Now().Unix()).Order("last_used_at DESC").Find(&sessions).Error
+	if err != nil {
+		log.Errorf("failed to list sessions: %v", err)
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
 func (db *postgres_db) DeleteSession(guid string, session_id uint) error {
 	if err := db.PostgresDB.Where("user_guid = ? AND id = ?", guid, session_id).Delete(&model.Session{}).Error; err != nil {
 		log.Errorf("failed to delete session: %v", err)
@@ -122,3 +408,117 @@ func (db *postgres_db) DeleteSession(guid string, session_id uint) error {
 
 	return nil
 }
+
+// AddActionToken saves token and deletes unused tokens of the same user and purpose, so only the latest one works.
+// Tokens without user (e.g. passkey login ceremonies) and authorization codes are independent, user can
+// authorize several clients at once.
+func (db *postgres_db) AddActionToken(action_token *model.ActionToken) error {
+	err := db.PostgresDB.Transaction(func(tx *gorm.DB) error {
+		if action_token.UserGUID == "" || action_token.Purpose == auth.OAuthCodePurpose {
+			return tx.Create(action_token).Error
+		}
+
+		err := tx.Where("user_guid = ? AND purpose = ? AND used_at = 0", action_token.UserGUID, action_token.Purpose).Delete(&model.ActionToken{}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(action_token).Error
+	})
+	if err != nil {
+		log.Errorf("failed to add action token: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (db *postgres_db) SearchActionToken(purpose string, selector string) (*model.ActionToken, error) {
+	action_token := model.ActionToken{}
+	if err := db.PostgresDB.Where("purpose = ? AND selector = ?", purpose, selector).First(&action_token).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Errorf("failed to find action token: %v", err)
+		}
+		return nil, err
+	}
+
+	return &action_token, nil
+}
+
+// useActionToken marks token used, ErrActionTokenUsed means concurrent request used it first
+func useActionToken(tx *gorm.DB, action_token *model.ActionToken) error {
+	result := tx.Model(&model.ActionToken{}).Where("id = ? AND used_at = 0", action_token.ID).Update("used_at", time.Now().Unix())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrActionTokenUsed
+	}
+
+	return nil
+}
+
+// ResetPassword marks reset token used, sets new password and deletes all sessions of user in one transaction.
+// Deleted sessions are returned, so their access tokens can be revoked. Reset link was delivered by email,
+// so email is marked verified as well.
+func (db *postgres_db) ResetPassword(action_token *model.ActionToken, password_hash string) ([]model.Session, error) {
+	sessions := []model.Session{}
+	err := db.PostgresDB.Transaction(func(tx *gorm.DB) error {
+		if err := useActionToken(tx, action_token); err != nil {
+			return err
+		}
+
+		err := tx.Model(&model.User{}).Where("guid = ?", action_token.UserGUID).Updates(map[string]interface{}{"password_hash": password_hash, "email_verified": true}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.Returning{}).Where("user_guid = ?", action_token.UserGUID).Delete(&sessions).Error
+	})
+	if err != nil {
+		log.Errorf("failed to reset password: %v", err)
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (db *postgres_db) VerifyEmail(action_token *model.ActionToken) error {
+	err := db.PostgresDB.Transaction(func(tx *gorm.DB) error {
+		if err := useActionToken(tx, action_token); err != nil {
+			return err
+		}
+
+		return tx.Model(&model.User{}).Where("guid = ?", action_token.UserGUID).Update("email_verified", true).Error
+	})
+	if err != nil {
+		log.Errorf("failed to verify email: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// UsePasswordlessCode marks login code used, only unused codes of passwordless purpose are accepted.
+// Code was delivered to the mailbox, so email of user becomes verified in the same transaction.
+func (db *postgres_db) UsePasswordlessCode(action_token *model.ActionToken) error {
+	err := db.PostgresDB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.ActionToken{}).Where("id = ? AND purpose = ? AND used_at = 0", action_token.ID, auth.PasswordlessLoginPurpose).Update("used_at", time.Now().Unix())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrActionTokenUsed
+		}
+
+		return tx.Model(&model.User{}).Where("guid = ?", action_token.UserGUID).Update("email_verified", true).Error
+	})
+	if err != nil {
+		if !errors.Is(err, ErrActionTokenUsed) {
+			log.Errorf("failed to use passwordless code: %v", err)
+		}
+		return err
+	}
+
+	return nil
+}
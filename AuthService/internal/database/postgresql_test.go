@@ -0,0 +1,92 @@
+package database
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"AuthService/internal/model"
+)
+
+// testDB connects to postgres from TEST_POSTGRES_DSN, tests are skipped without it.
+// Tests use random guids, so database may be shared with other runs.
+func testDB(t *testing.T) *postgres_db {
+	t.Helper()
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true, Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("failed to connect to postgres: %v", err)
+	}
+	if err := migrate(db); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	return NewPostgresDB(db)
+}
+
+func countSessions(t *testing.T, db *postgres_db, guid string) int64 {
+	t.Helper()
+	var count int64
+	if err := db.PostgresDB.Model(&model.Session{}).Where("user_guid = ?", guid).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count sessions: %v", err)
+	}
+
+	return count
+}
+
+func TestWithUserLock(t *testing.T) {
+	db := testDB(t)
+	guid := uuid.New().String()
+
+	//requests of one user are serialized, another user is not blocked by them
+	var running, max_running int32
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := db.WithUserLock(guid, func() error {
+				current := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+				for {
+					seen := atomic.LoadInt32(&max_running)
+					if current <= seen || atomic.CompareAndSwapInt32(&max_running, seen, current) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				return nil
+			})
+			if err != nil {
+				t.Errorf("WithUserLock() = %v", err)
+			}
+		}()
+	}
+
+	other_done := make(chan error)
+	go func() { other_done <- db.WithUserLock(uuid.New().String(), func() error { return nil }) }()
+	select {
+	case err := <-other_done:
+		if err != nil {
+			t.Errorf("WithUserLock() of another user = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("another user waits for the lock")
+	}
+
+	wg.Wait()
+	if max_running != 1 {
+		t.Errorf("%v functions ran under the lock at once", max_running)
+	}
+}
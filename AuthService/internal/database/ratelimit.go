@@ -0,0 +1,72 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	log "github.com/sirupsen/logrus"
+)
+
+// RateLimiter counts attempts in fixed window. Allow returns false and time until window ends
+// when key made more than limit attempts. Limited checks the same without counting an attempt,
+// it is used when only failed attempts are counted.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error)
+	Limited(ctx context.Context, key string, limit int) (bool, time.Duration, error)
+}
+
+func (redis_manager *redis_manager) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error) {
+	var count *redis.IntCmd
+	var ttl *redis.DurationCmd
+	_, err := redis_manager.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		count = pipe.Incr(ctx, rateLimitKey(key))
+		pipe.ExpireNX(ctx, rateLimitKey(key), window)
+		ttl = pipe.TTL(ctx, rateLimitKey(key))
+		return nil
+	})
+	if err != nil {
+		log.Errorf("failed to count attempt: %v", err)
+		return false, 0, err
+	}
+
+	if count.Val() > int64(limit) {
+		return false, ttl.Val(), nil
+	}
+
+	return true, 0, nil
+}
+
+func (redis_manager *redis_manager) Limited(ctx context.Context, key string, limit int) (bool, time.Duration, error) {
+	var count *redis.StringCmd
+	var ttl *redis.DurationCmd
+	_, err := redis_manager.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		count = pipe.Get(ctx, rateLimitKey(key))
+		ttl = pipe.TTL(ctx, rateLimitKey(key))
+		return nil
+	})
+	if errors.Is(err, redis.Nil) {
+		return false, 0, nil
+	}
+	if err != nil {
+		log.Errorf("failed to check attempts: %v", err)
+		return false, 0, err
+	}
+
+	attempts, err := count.Int64()
+	if err != nil {
+		log.Errorf("invalid attempts counter: %v", err)
+		return false, 0, err
+	}
+	if attempts >= int64(limit) {
+		return true, ttl.Val(), nil
+	}
+
+	return false, 0, nil
+}
+
+func rateLimitKey(key string) string {
+	return fmt.Sprintf("ratelimit:%v", key)
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// testRedis connects to redis from TEST_REDIS_ADDR, tests are skipped without it
+func testRedis(t *testing.T) *redis_manager {
+	t.Helper()
+	addr := os.Getenv("TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("TEST_REDIS_ADDR is not set")
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: addr, Password: os.Getenv("TEST_REDIS_PASSWORD")})
+	t.Cleanup(func() { client.Close() })
+	return &redis_manager{RedisClient: client}
+}
+
+func TestAllow(t *testing.T) {
+	manager := testRedis(t)
+	ctx := context.Background()
+	key := "test:" + uuid.New().String()
+	const limit = 3
+
+	for attempt := 1; attempt <= limit+1; attempt++ {
+		allowed, retry_after, err := manager.Allow(ctx, key, limit, time.Minute)
+		if err != nil {
+			t.Fatalf("Allow() = %v", err)
+		}
+		if allowed != (attempt <= limit) {
+			t.Fatalf("attempt %v allowed = %v", attempt, allowed)
+		}
+		if !allowed && (retry_after <= 0 || retry_after > time.Minute) {
+			t.Errorf("retry after %v, want up to a minute", retry_after)
+		}
+	}
+
+	//limit is counted per key
+	allowed, _, err := manager.Allow(ctx, key+":other", limit, time.Minute)
+	if err != nil || !allowed {
+		t.Errorf("other key Allow() = %v, %v", allowed, err)
+	}
+}
+
+func TestLimited(t *testing.T) {
+	manager := testRedis(t)
+	ctx := context.Background()
+	key := "test:" + uuid.New().String()
+	const limit = 2
+
+	for failure := 0; failure <= limit; failure++ {
+		locked, retry_after, err := manager.Limited(ctx, key, limit)
+		if err != nil {
+			t.Fatalf("Limited() = %v", err)
+		}
+		if locked != (failure >= limit) {
+			t.Fatalf("after %v failures locked = %v", failure, locked)
+		}
+		if locked && (retry_after <= 0 || retry_after > time.Minute) {
+			t.Errorf("retry after %v, want up to a minute", retry_after)
+		}
+
+		if _, _, err := manager.Allow(ctx, key, limit, time.Minute); err != nil {
+			t.Fatalf("Allow() = %v", err)
+		}
+	}
+}
@@ -13,9 +13,13 @@ import (
 	"AuthService/source/utils"
 )
 
+// BlacklistManager revokes access tokens before they expire. Tokens are revoked one by one (by jti),
+// by session or by user, BlacklistCheck consults all of these markers.
 type BlacklistManager interface {
-	AddToBlacklist(token string, expiry int64, ctx context.Context) error
-	BlacklistCheck(ctx context.Context, token string) (bool, error)
+	AddToBlacklist(ctx context.Context, claims *auth.TokenClaims) error
+	RevokeSession(ctx context.Context, session_id uint, expiry int64) error
+	RevokeUser(ctx context.Context, guid string, expiry int64) error
+	BlacklistCheck(ctx context.Context, claims *auth.TokenClaims) (bool, error)
 }
 
 type redis_manager struct {
@@ -40,34 +44,88 @@ func NewRedisManager() *redis_manager {
 	return &redis_manager{RedisClient: client}
 }
 
-func (redis_manager *redis_manager) AddToBlacklist(token string, expiry int64, ctx context.Context) error {
-	exparation_time := time.Duration(expiry-time.Now().Unix()) * time.Second
-	if err := redis_manager.RedisClient.Set(ctx, token, "revoked", exparation_time); err.Err() != nil {
-		log.Errorf("failed to add token to blacklist: %v", err.Err())
-		return err.Err()
+func (redis_manager *redis_manager) AddToBlacklist(ctx context.Context, claims *auth.TokenClaims) error {
+	if err := redis_manager.setMarker(ctx, tokenKey(claims.Id), "revoked", claims.ExpiresAt); err != nil {
+		log.Errorf("failed to add token to blacklist: %v", err)
+		return err
 	}
 
 	return nil
 }
 
-//bool values is {false: not blacklisted, true: blacklisted}
-func (redis_manager *redis_manager) BlacklistCheck(ctx context.Context, token string) (bool, error) {
-	user_token, err := auth.ExtractToken(token)
-	if err != nil {
-		log.Errorf("failed to extract token: %v", err)
-		return false, err
+// RevokeSession blacklists all access tokens of session, expiry should be not earlier than expiry of the last issued token
+func (redis_manager *redis_manager) RevokeSession(ctx context.Context, session_id uint, expiry int64) error {
+	if err := redis_manager.setMarker(ctx, sessionKey(session_id), "revoked", expiry); err != nil {
+		log.Errorf("failed to add session to blacklist: %v", err)
+		return err
 	}
-	result, err := redis_manager.RedisClient.Get(ctx, user_token).Result()
-	if err == redis.Nil {
-		return false, nil
+
+	return nil
+}
+
+// RevokeUser invalidates all access tokens of user issued before the call returns. iat has second granularity,
+// so tokens of the revocation second are rejected as well, and the call waits until that second is over:
+// login right after revocation gets token of a later second. expiry should be not earlier than expiry of
+// the last issued token.
+func (redis_manager *redis_manager) RevokeUser(ctx context.Context, guid string, expiry int64) error {
+	revoked_at := time.Now().Unix()
+	if err := redis_manager.setMarker(ctx, userKey(guid), strconv.FormatInt(revoked_at, 10), expiry); err != nil {
+		log.Errorf("failed to add user to blacklist: %v", err)
+		return err
 	}
-	if err != nil{
-		log.Errorf("failed to get token: %v", err)
+
+	select {
+	case <-time.After(time.Until(time.Unix(revoked_at+1, 0))):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+//bool values is {false: not blacklisted, true: blacklisted}
+func (redis_manager *redis_manager) BlacklistCheck(ctx context.Context, claims *auth.TokenClaims) (bool, error) {
+	markers, err := redis_manager.RedisClient.MGet(ctx, tokenKey(claims.Id), sessionKey(claims.SessionId), userKey(claims.GUID)).Result()
+	if err != nil {
+		log.Errorf("failed to get blacklist markers: %v", err)
 		return false, fmt.Errorf("failed to check token blacklisted")
 	}
-	if result == "revoked" {
+
+	if markers[0] == "revoked" || markers[1] == "revoked" {
 		return true, nil
 	}
 
+	if revoked_at, ok := markers[2].(string); ok {
+		revoked_at, err := strconv.ParseInt(revoked_at, 10, 64)
+		if err != nil {
+			log.Errorf("invalid user revocation time: %v", err)
+			return false, fmt.Errorf("failed to check token blacklisted")
+		}
+		//token of the revocation second may be issued before it, new logins get later iat
+		return claims.IssuedAt <= revoked_at, nil
+	}
+
 	return false, nil
 }
+
+func (redis_manager *redis_manager) setMarker(ctx context.Context, key string, value string, expiry int64) error {
+	exparation_time := time.Duration(expiry-time.Now().Unix()) * time.Second
+	//expired token is rejected anyway, and redis keeps keys with negative ttl forever
+	if exparation_time <= 0 {
+		return nil
+	}
+
+	return redis_manager.RedisClient.Set(ctx, key, value, exparation_time).Err()
+}
+
+func tokenKey(token_id string) string {
+	return fmt.Sprintf("token:%v", token_id)
+}
+
+func sessionKey(session_id uint) string {
+	return fmt.Sprintf("session:%d", session_id)
+}
+
+func userKey(guid string) string {
+	return fmt.Sprintf("user:%v", guid)
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+
+	"AuthService/internal/auth"
+)
+
+func testClaims(guid string, session_id uint, issued_at int64) *auth.TokenClaims {
+	return &auth.TokenClaims{GUID: guid, SessionId: session_id, StandardClaims: jwt.StandardClaims{Id: uuid.New().String(), IssuedAt: issued_at, ExpiresAt: issued_at + 60}}
+}
+
+func TestBlacklistCheck(t *testing.T) {
+	manager := testRedis(t)
+	ctx := context.Background()
+	now := time.Now().Unix()
+	expiry := now + 60
+	guid := uuid.New().String()
+	session_id := uint(time.Now().UnixNano() % 1_000_000_000)
+
+	revoked_token := testClaims(guid, session_id+1, now)
+	if err := manager.AddToBlacklist(ctx, revoked_token); err != nil {
+		t.Fatalf("AddToBlacklist() = %v", err)
+	}
+	if err := manager.RevokeSession(ctx, session_id, expiry); err != nil {
+		t.Fatalf("RevokeSession() = %v", err)
+	}
+	if err := manager.RevokeUser(ctx, guid, expiry); err != nil {
+		t.Fatalf("RevokeUser() = %v", err)
+	}
+	//RevokeUser returns after the second of revocation, so token issued now is a new login
+	after := time.Now().Unix()
+	if after <= now {
+		t.Fatalf("RevokeUser() returned in the second of revocation")
+	}
+
+	tests := []struct {
+		name        string
+		claims      *auth.TokenClaims
+		blacklisted bool
+	}{
+		{"revoked token", revoked_token, true},
+		{"another token of the same session", testClaims(uuid.New().String(), session_id+1, now+10), false},
+		{"token of revoked session", testClaims(uuid.New().String(), session_id, now+10), true},
+		{"token of user issued before revocation", testClaims(guid, session_id+2, now-10), true},
+		{"token of user issued in the second of revocation", testClaims(guid, session_id+2, now), true},
+		{"token of user issued after revocation", testClaims(guid, session_id+2, after), false},
+		{"token of another user", testClaims(uuid.New().String(), session_id+2, now-10), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blacklisted, err := manager.BlacklistCheck(ctx, test.claims)
+			if err != nil {
+				t.Fatalf("BlacklistCheck() = %v", err)
+			}
+			if blacklisted != test.blacklisted {
+				t.Fatalf("BlacklistCheck() = %v, want %v", blacklisted, test.blacklisted)
+			}
+		})
+	}
+
+	//markers of already expired tokens are not stored
+	expired := testClaims(uuid.New().String(), session_id+3, now-120)
+	if err := manager.AddToBlacklist(ctx, expired); err != nil {
+		t.Fatalf("AddToBlacklist() = %v", err)
+	}
+	if exists, err := manager.RedisClient.Exists(ctx, tokenKey(expired.Id)).Result(); err != nil || exists != 0 {
+		t.Errorf("marker of expired token exists: %v, %v", exists, err)
+	}
+}
@@ -0,0 +1,193 @@
+package database
+
+import (
+	"encoding/base64"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"AuthService/internal/auth"
+	"AuthService/internal/model"
+	"AuthService/source/utils"
+)
+
+func testRefreshGenerator() auth.RefreshManager {
+	return auth.NewRefreshGenerator(32, 24*time.Hour, time.Hour, "pepper")
+}
+
+func TestRotateLegacySession(t *testing.T) {
+	db := testDB(t)
+	generator := testRefreshGenerator()
+	guid := uuid.New().String()
+	now := time.Now().Unix()
+
+	//sessions created before selector tokens have NULL selector and bcrypt hash of the whole token
+	legacy_tokens := []string{uuid.New().String(), uuid.New().String()}
+	legacy_sessions := []model.Session{}
+	for _, token := range legacy_tokens {
+		hash, err := utils.GenerateHash(token)
+		if err != nil {
+			t.Fatalf("GenerateHash() = %v", err)
+		}
+		session := model.Session{UserGUID: guid, Refresh: hash, CreatedAt: now, LastUsedAt: now, ExpiresAt: now + 3600, UserAgent: "agent"}
+		if err := db.PostgresDB.Create(&session).Error; err != nil {
+			t.Fatalf("failed to create legacy session: %v", err)
+		}
+		legacy_sessions = append(legacy_sessions, session)
+	}
+
+	if err := generator.CheckExpiry(&legacy_sessions[0]); err != nil {
+		t.Fatalf("CheckExpiry() = %v", err)
+	}
+	_, refresh, err := db.RotateSession(&legacy_sessions[0], legacy_tokens[0], generator, 24*time.Hour, "agent", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("RotateSession() = %v", err)
+	}
+	if _, err := auth.ParseRefreshToken(refresh); err != nil {
+		t.Errorf("rotated refresh %q has no selector", refresh)
+	}
+
+	_, _, err = db.RotateSession(&legacy_sessions[0], legacy_tokens[0], generator, 24*time.Hour, "agent", "127.0.0.1")
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("second RotateSession() = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+
+	used, err := db.SearchUsedRefresh(legacy_tokens[0])
+	if err != nil {
+		t.Fatalf("reuse of legacy token is not detectable: %v", err)
+	}
+	if used.SessionID != legacy_sessions[0].ID {
+		t.Errorf("used refresh of session %v, want %v", used.SessionID, legacy_sessions[0].ID)
+	}
+
+	if count := countSessions(t, db, guid); count != 2 {
+		t.Errorf("user has %v sessions, want 2", count)
+	}
+}
+
+func TestSearchLegacySessionByRefresh(t *testing.T) {
+	db := testDB(t)
+	generator := testRefreshGenerator()
+	guid := uuid.New().String()
+	now := time.Now().Unix()
+
+	//tokens of that time were base64 strings, their sessions have neither selector nor digest
+	legacy_token := base64.StdEncoding.EncodeToString([]byte(uuid.New().String()))
+	hash, err := utils.GenerateHash(legacy_token)
+	if err != nil {
+		t.Fatalf("GenerateHash() = %v", err)
+	}
+	legacy := model.Session{UserGUID: guid, Refresh: hash, CreatedAt: now, LastUsedAt: now, ExpiresAt: now + 3600, UserAgent: "agent"}
+	if err := db.PostgresDB.Create(&legacy).Error; err != nil {
+		t.Fatalf("failed to create legacy session: %v", err)
+	}
+
+	session, err := db.SearchSessionByRefresh(legacy_token)
+	if err != nil || session.ID != legacy.ID {
+		t.Fatalf("SearchSessionByRefresh() = %v", err)
+	}
+	if !generator.VerifyRefresh(legacy_token, session) {
+		t.Errorf("VerifyRefresh() rejected legacy token")
+	}
+
+	//digest is saved by the first lookup
+	saved, err := db.SearchSession(guid, legacy.ID)
+	if err != nil {
+		t.Fatalf("SearchSession() = %v", err)
+	}
+	if saved.RefreshDigest != utils.HashToken(legacy_token) {
+		t.Errorf("refresh digest %q is not saved", saved.RefreshDigest)
+	}
+	if session, err := db.SearchSessionByRefresh(legacy_token); err != nil || session.ID != legacy.ID {
+		t.Errorf("SearchSessionByRefresh() by digest = %v", err)
+	}
+
+	wrong_token := base64.StdEncoding.EncodeToString([]byte(uuid.New().String()))
+	if _, err := db.SearchSessionByRefresh(wrong_token); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("SearchSessionByRefresh() of unknown token = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+}
+
+func TestRotateSessionConcurrent(t *testing.T) {
+	db := testDB(t)
+	generator := testRefreshGenerator()
+	guid := uuid.New().String()
+
+	session_id, refresh, err := db.AddSession(guid, "", "", generator, 24*time.Hour, "agent", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("AddSession() = %v", err)
+	}
+	session, err := db.SearchSession(guid, session_id)
+	if err != nil {
+		t.Fatalf("SearchSession() = %v", err)
+	}
+
+	const attempts = 10
+	errs := make([]error, attempts)
+	start := make(chan struct{})
+	wg := sync.WaitGroup{}
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			old := *session
+			_, _, errs[i] = db.RotateSession(&old, refresh, generator, 24*time.Hour, "agent", "127.0.0.1")
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	rotated := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			rotated++
+		case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, ErrRefreshMismatch):
+		default:
+			t.Errorf("RotateSession() = %v, want already used error", err)
+		}
+	}
+	if rotated != 1 {
+		t.Errorf("refresh rotated %v times, want 1", rotated)
+	}
+
+	//only the child of the winner is left
+	if count := countSessions(t, db, guid); count != 1 {
+		t.Errorf("user has %v sessions, want 1", count)
+	}
+	if _, err := db.SearchUsedRefresh(refresh); err != nil {
+		t.Errorf("used refresh is not remembered: %v", err)
+	}
+}
+
+func TestRotateSessionKeepsClientAndScope(t *testing.T) {
+	db := testDB(t)
+	generator := testRefreshGenerator()
+	guid := uuid.New().String()
+
+	session_id, refresh, err := db.AddSession(guid, "web-app", "openid profile", generator, 24*time.Hour, "agent", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("AddSession() = %v", err)
+	}
+	session, err := db.SearchSessionByRefresh(refresh)
+	if err != nil || session.ID != session_id {
+		t.Fatalf("SearchSessionByRefresh() = %v", err)
+	}
+
+	child_id, _, err := db.RotateSession(session, refresh, generator, 24*time.Hour, "agent", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("RotateSession() = %v", err)
+	}
+	child, err := db.SearchSession(guid, child_id)
+	if err != nil {
+		t.Fatalf("SearchSession() = %v", err)
+	}
+	if child.ClientID != "web-app" || child.Scope != "openid profile" || child.FamilyID != session.FamilyID {
+		t.Errorf("child session %+v doesn't inherit client, scope and family of %+v", child, session)
+	}
+}
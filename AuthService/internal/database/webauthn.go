@@ -0,0 +1,48 @@
+package database
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"AuthService/internal/model"
+)
+
+func (db *postgres_db) AddWebAuthnCredential(credential *model.WebAuthnCredential) error {
+	if err := db.PostgresDB.Create(credential).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrPasskeyExists
+		}
+		log.Errorf("failed to add passkey: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (db *postgres_db) ListWebAuthnCredentials(guid string) ([]model.WebAuthnCredential, error) {
+	credentials := []model.WebAuthnCredential{}
+	if err := db.PostgresDB.Where("user_guid = ?", guid).Order("created_at").Find(&credentials).Error; err != nil {
+		log.Errorf("failed to list passkeys: %v", err)
+		return nil, err
+	}
+
+	return credentials, nil
+}
+
+// UpdateWebAuthnCredential saves sign count after login. Count only grows, so concurrent login with
+// the same assertion can't move it back.
+func (db *postgres_db) UpdateWebAuthnCredential(credential *model.WebAuthnCredential) error {
+	err := db.PostgresDB.Model(&model.WebAuthnCredential{}).Where("id = ? AND (sign_count < ? OR sign_count = 0)", credential.ID, credential.SignCount).Updates(map[string]interface{}{
+		"sign_count":   credential.SignCount,
+		"backup_state": credential.BackupState,
+		"last_used_at": credential.LastUsedAt,
+	}).Error
+	if err != nil {
+		log.Errorf("failed to update passkey: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package model
+
+// ActionToken is short-lived single-use token sent to user to confirm some action, e.g. password reset.
+// Like refresh tokens it is given out as "selector.verifier" and only HMAC of verifier is stored.
+type ActionToken struct {
+	ID           uint   `gorm:"primaryKey;autoIncrement"`
+	Purpose      string `gorm:"index"`
+	Selector     string `gorm:"uniqueIndex"`
+	VerifierHash string
+	UserGUID     string `gorm:"index"`
+	CreatedAt    int64
+	ExpiresAt    int64
+	UsedAt       int64
+	Attempts     int    //wrong codes entered for token, used by MFA challenges
+	Data         string //state kept between steps, e.g. WebAuthn session data
+}
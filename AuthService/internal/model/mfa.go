@@ -0,0 +1,20 @@
+package model
+
+// TOTPCredential is authenticator app of user. It is created disabled by enrollment and enabled
+// after the first valid code. LastStep is time step of the last accepted code, codes of this
+// and earlier steps are rejected, so intercepted code can't be replayed.
+type TOTPCredential struct {
+	UserGUID  string `gorm:"primaryKey"`
+	Secret    string //encrypted with TOTP_ENCRYPTION_KEY
+	Enabled   bool   `gorm:"not null;default:false"`
+	LastStep  int64
+	CreatedAt int64
+}
+
+// RecoveryCode replaces TOTP code once when authenticator app is lost, only HMAC of code is stored
+type RecoveryCode struct {
+	ID       uint   `gorm:"primaryKey;autoIncrement"`
+	UserGUID string `gorm:"index"`
+	CodeHash string `gorm:"index"`
+	UsedAt   int64
+}
@@ -0,0 +1,15 @@
+package model
+
+// OAuthClient is application registered to log users in. Lists are space separated like OAuth scope.
+type OAuthClient struct {
+	ID              string `gorm:"primaryKey"` //client_id
+	SecretHash      string //empty for public clients
+	GrantTypes      string
+	RedirectURIs    string
+	Scopes          string
+	AccessLifeTime  int64 //seconds, 0 means ACCESS_LIFE_TIME
+	RefreshLifeTime int64 //seconds, 0 means REFRESH_LIFE_TIME
+	Disabled        bool  `gorm:"not null;default:false"`
+	CreatedAt       int64
+	UpdatedAt       int64
+}
@@ -1,10 +1,18 @@
 package model
 
 type Session struct {
-	ID        uint   `gorm:"primaryKey;autoIncrement"`
-	UserGUID  string `gorm:"index"`
-	Refresh   string
-	ExpiresAt int64
-	UserIP    string
-	UserAgent string
+	ID            uint    `gorm:"primaryKey;autoIncrement"`
+	UserGUID      string  `gorm:"index"`
+	Selector      *string `gorm:"uniqueIndex"` //NULL for sessions created before selector tokens
+	Refresh       string
+	RefreshDigest string `gorm:"index"`
+	FamilyID      string `gorm:"index"`
+	ParentID      uint
+	CreatedAt     int64 //login time of session family, kept on rotation like ExpiresAt
+	LastUsedAt    int64
+	ExpiresAt     int64
+	UserIP        string
+	UserAgent     string
+	ClientID      string `gorm:"index"` //empty for first-party logins
+	Scope         string
 }
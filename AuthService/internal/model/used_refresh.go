@@ -0,0 +1,10 @@
+package model
+
+// UsedRefreshToken remembers refresh token of rotated session, presenting it again means the token was stolen
+type UsedRefreshToken struct {
+	RefreshDigest string `gorm:"primaryKey"`
+	FamilyID      string `gorm:"index"`
+	UserGUID      string
+	SessionID     uint
+	ExpiresAt     int64
+}
@@ -1,6 +1,11 @@
 package model
 
 type User struct {
-	GUID     string    `json:"guid" gorm:"primarykey"`
-	Sessions []Session `gorm:"foreignKey:UserGUID"`
+	GUID          string    `json:"guid" gorm:"primarykey"`
+	Email         *string   `json:"email,omitempty" gorm:"uniqueIndex"`
+	EmailVerified bool      `json:"email_verified" gorm:"not null;default:false"`
+	Username      *string   `json:"username,omitempty" gorm:"uniqueIndex"`
+	PasswordHash  string    `json:"-"`
+	CreatedAt     int64     `json:"created_at"`
+	Sessions      []Session `gorm:"foreignKey:UserGUID"`
 }
@@ -0,0 +1,19 @@
+package model
+
+// WebAuthnCredential is passkey of user. CredentialID and PublicKey (COSE encoded) come from authenticator
+// on registration, SignCount grows with every login unless authenticator doesn't count at all.
+type WebAuthnCredential struct {
+	ID              uint   `gorm:"primaryKey;autoIncrement"`
+	UserGUID        string `gorm:"index"`
+	CredentialID    []byte `gorm:"uniqueIndex"`
+	PublicKey       []byte
+	AttestationType string
+	Transports      string //comma separated, e.g. "internal,hybrid"
+	AAGUID          []byte
+	SignCount       uint32
+	BackupEligible  bool `gorm:"not null;default:false"`
+	BackupState     bool `gorm:"not null;default:false"`
+	Name            string
+	CreatedAt       int64
+	LastUsedAt      int64
+}
@@ -0,0 +1,45 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// file_mailer writes every email to MAIL_DIR as .eml file which can be opened by any mail client,
+// never use it in production
+type file_mailer struct {
+	From *mail.Address
+	Dir  string
+}
+
+func newFileMailer(from *mail.Address, dir string) (*file_mailer, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("MAIL_DIR is empty")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	return &file_mailer{From: from, Dir: dir}, nil
+}
+
+func (mailer *file_mailer) SendMail(ctx context.Context, to string, subject string, body string) error {
+	message, err := composeMail(mailer.From, to, subject, body)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(mailer.Dir, fmt.Sprintf("%v.eml", time.Now().UnixNano()))
+	if err := os.WriteFile(path, message, 0o600); err != nil {
+		return err
+	}
+	log.Infof("email %q to %v written to %v", subject, to, path)
+
+	return nil
+}
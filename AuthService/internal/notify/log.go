@@ -0,0 +1,15 @@
+package notify
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// log_notifier prints messages with tokens to log, never use it in production
+type log_notifier struct{}
+
+func (notifier *log_notifier) Notify(ctx context.Context, message *Message) error {
+	log.Warnf("notification %v to %v: %v %v", message.Kind, message.To, message.Body, message.Link)
+	return nil
+}
@@ -0,0 +1,82 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"AuthService/source/utils"
+)
+
+// Mailer sends plain text email
+type Mailer interface {
+	SendMail(ctx context.Context, to string, subject string, body string) error
+}
+
+// NewMailer picks mailer by MAILER value: smtp sends through SMTP_HOST, file writes .eml files
+// to MAIL_DIR and is meant for development
+func NewMailer() (Mailer, error) {
+	from, err := mail.ParseAddress(utils.GetKeyFromEnv("MAIL_FROM"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAIL_FROM: %v", err)
+	}
+
+	switch kind := utils.GetKeyFromEnv("MAILER"); kind {
+	case "smtp":
+		return newSMTPMailer(from)
+	case "file":
+		return newFileMailer(from, utils.GetKeyFromEnv("MAIL_DIR"))
+	default:
+		return nil, fmt.Errorf("unknown mailer: %v", kind)
+	}
+}
+
+// mail_notifier delivers notifications by email, link is appended to body
+type mail_notifier struct {
+	Mailer Mailer
+}
+
+func (notifier *mail_notifier) Notify(ctx context.Context, message *Message) error {
+	body := message.Body
+	if message.Link != "" {
+		body += "\r\n\r\n" + message.Link
+	}
+
+	return notifier.Mailer.SendMail(ctx, message.To, message.Subject, body)
+}
+
+// composeMail builds RFC 5322 message. Body is quoted-printable, so any utf-8 text is safe.
+func composeMail(from *mail.Address, to string, subject string, body string) ([]byte, error) {
+	to_address, err := mail.ParseAddress(to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient: %v", err)
+	}
+	domain := from.Address[strings.LastIndex(from.Address, "@")+1:]
+
+	message := bytes.Buffer{}
+	fmt.Fprintf(&message, "From: %v\r\n", from.String())
+	fmt.Fprintf(&message, "To: %v\r\n", to_address.String())
+	fmt.Fprintf(&message, "Subject: %v\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&message, "Date: %v\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&message, "Message-ID: <%v@%v>\r\n", uuid.New().String(), domain)
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	message.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	writer := quotedprintable.NewWriter(&message)
+	if _, err := writer.Write([]byte(body)); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return message.Bytes(), nil
+}
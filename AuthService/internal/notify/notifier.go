@@ -0,0 +1,42 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"AuthService/source/utils"
+)
+
+// Message is notification for user. Kind tells what it is about, e.g. password_reset,
+// so sender can pick template. Link is url with token user has to open.
+type Message struct {
+	Kind    string
+	To      string
+	Subject string
+	Body    string
+	Link    string
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, message *Message) error
+}
+
+// NewNotifier picks sender by NOTIFIER value: log writes messages to service log and is meant for
+// development, mail sends them by email with mailer from NewMailer. Messages carry tokens, so they are
+// never posted to WEBHOOK_URL, which gets only security events.
+func NewNotifier() (Notifier, error) {
+	switch kind := utils.GetKeyFromEnv("NOTIFIER"); kind {
+	case "log":
+		return &log_notifier{}, nil
+	case "webhook":
+		return nil, fmt.Errorf("webhook notifier is not supported, messages with tokens must not be sent to webhook, use mail")
+	case "mail":
+		mailer, err := NewMailer()
+		if err != nil {
+			return nil, err
+		}
+		return &mail_notifier{Mailer: mailer}, nil
+	default:
+		return nil, fmt.Errorf("unknown notifier: %v", kind)
+	}
+}
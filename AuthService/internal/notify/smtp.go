@@ -0,0 +1,96 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"AuthService/source/utils"
+)
+
+const smtpTimeout = 10 * time.Second
+
+// smtp_mailer uses STARTTLS when server offers it. Without SMTP_USERNAME no authentication is done,
+// which is fine for local stand-ins like mailpit
+type smtp_mailer struct {
+	From     *mail.Address
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+func newSMTPMailer(from *mail.Address) (*smtp_mailer, error) {
+	port, err := strconv.Atoi(utils.GetKeyFromEnv("SMTP_PORT"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP_PORT: %v", err)
+	}
+
+	return &smtp_mailer{
+		From:     from,
+		Host:     utils.GetKeyFromEnv("SMTP_HOST"),
+		Port:     port,
+		Username: utils.GetKeyFromEnv("SMTP_USERNAME"),
+		Password: utils.GetKeyFromEnv("SMTP_PASSWORD"),
+	}, nil
+}
+
+func (mailer *smtp_mailer) SendMail(ctx context.Context, to string, subject string, body string) error {
+	message, err := composeMail(mailer.From, to, subject, body)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+
+	dialer := net.Dialer{}
+	connection, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(mailer.Host, strconv.Itoa(mailer.Port)))
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	connection.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(connection, mailer.Host)
+	if err != nil {
+		connection.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: mailer.Host}); err != nil {
+			return err
+		}
+	}
+	if mailer.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", mailer.Username, mailer.Password, mailer.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(mailer.From.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,77 @@
+package policy
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// minPrefixLength keeps false positives low, 5 hex chars prefix would match one of every million passwords
+const minPrefixLength = 10
+
+// BreachedList keeps hex SHA-1 prefixes of breached passwords. It is loaded from local file,
+// passwords are never sent anywhere. Prefixes may have different lengths.
+type BreachedList struct {
+	prefixes map[string]struct{}
+	lengths  []int
+}
+
+// LoadBreachedList reads file with one SHA-1 prefix per line. Text after ":" (breach count in
+// "have i been pwned" dumps) is ignored, empty lines and lines starting with # are skipped.
+func LoadBreachedList(path string) (*BreachedList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	list := BreachedList{prefixes: make(map[string]struct{})}
+	seen_lengths := make(map[int]bool)
+	scanner := bufio.NewScanner(file)
+	for line_number := 1; scanner.Scan(); line_number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		prefix, _, _ := strings.Cut(line, ":")
+		prefix = strings.ToUpper(strings.TrimSpace(prefix))
+		if strings.Trim(prefix, "0123456789ABCDEF") != "" || len(prefix) < minPrefixLength || len(prefix) > sha1.Size*2 {
+			return nil, fmt.Errorf("line %v: invalid sha1 prefix %q", line_number, prefix)
+		}
+
+		list.prefixes[prefix] = struct{}{}
+		if !seen_lengths[len(prefix)] {
+			seen_lengths[len(prefix)] = true
+			list.lengths = append(list.lengths, len(prefix))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+func (list *BreachedList) Contains(password string) bool {
+	if len(list.prefixes) == 0 {
+		return false
+	}
+
+	hash := sha1.Sum([]byte(password))
+	hex_hash := strings.ToUpper(hex.EncodeToString(hash[:]))
+	for _, length := range list.lengths {
+		if _, ok := list.prefixes[hex_hash[:length]]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (list *BreachedList) Len() int {
+	return len(list.prefixes)
+}
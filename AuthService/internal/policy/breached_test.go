@@ -0,0 +1,81 @@
+package policy
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func sha1Hex(password string) string {
+	hash := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(hash[:]))
+}
+
+func writeList(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write list: %v", err)
+	}
+
+	return path
+}
+
+func TestLoadBreachedList(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    int
+		wantErr bool
+	}{
+		{"prefixes with counts and comments", "# dump\n" + sha1Hex("password")[:10] + ":3861493\n\n" + strings.ToLower(sha1Hex("123456")) + "\n", 2, false},
+		{"empty", "", 0, false},
+		{"too short prefix", sha1Hex("password")[:5] + "\n", 0, true},
+		{"too long prefix", sha1Hex("password") + "0\n", 0, true},
+		{"not hex", "ZZZZZZZZZZZZ\n", 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list, err := LoadBreachedList(writeList(t, test.content))
+			if (err != nil) != test.wantErr {
+				t.Fatalf("LoadBreachedList() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err == nil && list.Len() != test.want {
+				t.Fatalf("Len() = %v, want %v", list.Len(), test.want)
+			}
+		})
+	}
+}
+
+func TestBreachedListContains(t *testing.T) {
+	list, err := LoadBreachedList(writeList(t, sha1Hex("password")[:10]+"\n"+sha1Hex("123456")+"\n"))
+	if err != nil {
+		t.Fatalf("LoadBreachedList() = %v", err)
+	}
+
+	tests := []struct {
+		password string
+		want     bool
+	}{
+		{"password", true},
+		{"123456", true},
+		{"Password", false},
+		{"correct horse battery staple", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.password, func(t *testing.T) {
+			if got := list.Contains(test.password); got != test.want {
+				t.Fatalf("Contains(%q) = %v, want %v", test.password, got, test.want)
+			}
+		})
+	}
+
+	if (&BreachedList{}).Contains("password") {
+		t.Errorf("empty list contains password")
+	}
+}
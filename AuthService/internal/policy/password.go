@@ -0,0 +1,134 @@
+package policy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	log "github.com/sirupsen/logrus"
+
+	"AuthService/source/utils"
+)
+
+const (
+	LowerClass  = "lower"
+	UpperClass  = "upper"
+	DigitClass  = "digit"
+	SymbolClass = "symbol"
+)
+
+// Violation is one failed rule, Reason is stable code for clients and Description is for humans
+type Violation struct {
+	Reason      string
+	Description string
+}
+
+type PasswordPolicy struct {
+	MinLength       int
+	MaxLength       int
+	RequiredClasses []string
+	//identifiers shorter than this are not searched in password, otherwise any password with "ab" in it is rejected
+	MinIdentifierLength int
+	Breached            *BreachedList
+}
+
+func NewPasswordPolicy() *PasswordPolicy {
+	min_length, err := strconv.Atoi(utils.GetKeyFromEnv("PASSWORD_MIN_LENGTH"))
+	if err != nil || min_length < 1 {
+		log.Fatalf("invalid PASSWORD_MIN_LENGTH: %v", utils.GetKeyFromEnv("PASSWORD_MIN_LENGTH"))
+	}
+
+	max_length, err := strconv.Atoi(utils.GetKeyFromEnv("PASSWORD_MAX_LENGTH"))
+	if err != nil || max_length < min_length {
+		log.Fatalf("invalid PASSWORD_MAX_LENGTH: %v, it must not be less than PASSWORD_MIN_LENGTH", utils.GetKeyFromEnv("PASSWORD_MAX_LENGTH"))
+	}
+
+	classes := []string{}
+	for _, class := range strings.Split(utils.GetKeyFromEnv("PASSWORD_REQUIRED_CLASSES"), ",") {
+		class = strings.TrimSpace(class)
+		switch class {
+		case "":
+			continue
+		case LowerClass, UpperClass, DigitClass, SymbolClass:
+			classes = append(classes, class)
+		default:
+			log.Fatalf("invalid PASSWORD_REQUIRED_CLASSES: unknown class %v", class)
+		}
+	}
+
+	breached := &BreachedList{}
+	if path := utils.GetKeyFromEnv("PASSWORD_BREACHED_LIST"); path != "" {
+		breached, err = LoadBreachedList(path)
+		if err != nil {
+			log.Fatalf("failed to load breached password list: %v", err)
+		}
+		log.Infof("loaded %v breached password prefixes from %v", breached.Len(), path)
+	}
+
+	return &PasswordPolicy{MinLength: min_length, MaxLength: max_length, RequiredClasses: classes, MinIdentifierLength: 3, Breached: breached}
+}
+
+// Check returns every rule password violates, identifiers are email and username of the user
+func (policy *PasswordPolicy) Check(password string, identifiers ...string) []Violation {
+	violations := []Violation{}
+
+	length := utf8.RuneCountInString(password)
+	if length < policy.MinLength {
+		violations = append(violations, Violation{"PASSWORD_TOO_SHORT", fmt.Sprintf("password must be at least %v characters", policy.MinLength)})
+	}
+	if length > policy.MaxLength {
+		violations = append(violations, Violation{"PASSWORD_TOO_LONG", fmt.Sprintf("password must be at most %v characters", policy.MaxLength)})
+	}
+
+	for _, class := range policy.RequiredClasses {
+		if !strings.ContainsFunc(password, classMatcher(class)) {
+			violations = append(violations, Violation{"PASSWORD_MISSING_" + strings.ToUpper(class), fmt.Sprintf("password must contain %v character", classDescription(class))})
+		}
+	}
+
+	lower_password := strings.ToLower(password)
+	for _, identifier := range identifiers {
+		//for email only local part is checked, domain is often something like gmail
+		identifier, _, _ = strings.Cut(strings.ToLower(identifier), "@")
+		if utf8.RuneCountInString(identifier) >= policy.MinIdentifierLength && strings.Contains(lower_password, identifier) {
+			violations = append(violations, Violation{"PASSWORD_CONTAINS_IDENTIFIER", "password must not contain email or username"})
+			break
+		}
+	}
+
+	if policy.Breached.Contains(password) {
+		violations = append(violations, Violation{"PASSWORD_BREACHED", "password was found in data breaches, choose another one"})
+	}
+
+	return violations
+}
+
+func classMatcher(class string) func(rune) bool {
+	switch class {
+	case LowerClass:
+		return unicode.IsLower
+	case UpperClass:
+		return unicode.IsUpper
+	case DigitClass:
+		return unicode.IsDigit
+	}
+
+	return func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r)
+	}
+}
+
+func classDescription(class string) string {
+	switch class {
+	case LowerClass:
+		return "a lowercase"
+	case UpperClass:
+		return "an uppercase"
+	case DigitClass:
+		return "a digit"
+	}
+
+	return "a special"
+}
@@ -0,0 +1,62 @@
+package policy
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func reasons(violations []Violation) []string {
+	result := []string{}
+	for _, violation := range violations {
+		result = append(result, violation.Reason)
+	}
+
+	return result
+}
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	hash := sha1.Sum([]byte("Breached1!"))
+	breached := &BreachedList{prefixes: map[string]struct{}{strings.ToUpper(hex.EncodeToString(hash[:]))[:10]: {}}, lengths: []int{10}}
+	policy := &PasswordPolicy{MinLength: 8, MaxLength: 16, RequiredClasses: []string{LowerClass, UpperClass, DigitClass, SymbolClass}, MinIdentifierLength: 3, Breached: breached}
+
+	tests := []struct {
+		name        string
+		password    string
+		identifiers []string
+		want        []string
+	}{
+		{"valid", "Str0ng!pass", nil, []string{}},
+		{"min length boundary", "Aa1!aaaa", nil, []string{}},
+		{"too short", "Aa1!aaa", nil, []string{"PASSWORD_TOO_SHORT"}},
+		{"max length boundary", "Aa1!aaaaaaaaaaaa", nil, []string{}},
+		{"too long", "Aa1!aaaaaaaaaaaaa", nil, []string{"PASSWORD_TOO_LONG"}},
+		{"length in runes", "Пароль1!Пароль1!", nil, []string{}},
+		{"missing classes", "password", nil, []string{"PASSWORD_MISSING_UPPER", "PASSWORD_MISSING_DIGIT", "PASSWORD_MISSING_SYMBOL"}},
+		{"space is not symbol", "Str0ng pass", nil, []string{"PASSWORD_MISSING_SYMBOL"}},
+		{"contains username", "Xjohnny1!", []string{"johnny"}, []string{"PASSWORD_CONTAINS_IDENTIFIER"}},
+		{"contains username in other case", "JOHNNY1!x", []string{"johnny"}, []string{"PASSWORD_CONTAINS_IDENTIFIER"}},
+		{"contains email local part", "Alice2024!", []string{"alice@example.com"}, []string{"PASSWORD_CONTAINS_IDENTIFIER"}},
+		{"email domain is ignored", "Example1!x", []string{"alice@example.com"}, []string{}},
+		{"short identifier is ignored", "Str0ng!ab", []string{"ab"}, []string{}},
+		{"breached", "Breached1!", nil, []string{"PASSWORD_BREACHED"}},
+		{"several rules", "bob", []string{"bob"}, []string{"PASSWORD_TOO_SHORT", "PASSWORD_MISSING_UPPER", "PASSWORD_MISSING_DIGIT", "PASSWORD_MISSING_SYMBOL", "PASSWORD_CONTAINS_IDENTIFIER"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := reasons(policy.Check(test.password, test.identifiers...)); !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("Check() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicyWithoutClasses(t *testing.T) {
+	policy := &PasswordPolicy{MinLength: 1, MaxLength: 64, MinIdentifierLength: 3, Breached: &BreachedList{}}
+	if violations := policy.Check("simple"); len(violations) != 0 {
+		t.Fatalf("Check() = %v, want no violations", reasons(violations))
+	}
+}
@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"AuthService/internal/database"
+	"AuthService/source/utils"
+)
+
+// reaperLockKey is postgres advisory lock key shared by all replicas of the service
+const reaperLockKey = 0x61757468726561
+
+// SessionReaper periodically deletes sessions which can't be refreshed anymore, used refresh
+// tokens kept for reuse detection and expired action tokens. Rows are deleted in batches, so big backlog doesn't lock the table.
+type SessionReaper struct {
+	DB        database.Database
+	Interval  time.Duration
+	BatchSize int
+	IdleTime  time.Duration
+}
+
+func NewSessionReaper(db database.Database, idle_time time.Duration) *SessionReaper {
+	interval, err := strconv.Atoi(utils.GetKeyFromEnv("REAPER_INTERVAL"))
+	if err != nil || interval < 0 {
+		log.Fatalf("invalid REAPER_INTERVAL: %v", utils.GetKeyFromEnv("REAPER_INTERVAL"))
+	}
+
+	batch_size, err := strconv.Atoi(utils.GetKeyFromEnv("REAPER_BATCH_SIZE"))
+	if err != nil || batch_size <= 0 {
+		log.Fatalf("invalid REAPER_BATCH_SIZE: %v", utils.GetKeyFromEnv("REAPER_BATCH_SIZE"))
+	}
+
+	return &SessionReaper{DB: db, Interval: time.Duration(interval) * time.Minute, BatchSize: batch_size, IdleTime: idle_time}
+}
+
+// Run reaps on every tick until ctx is done, zero interval disables reaper
+func (reaper *SessionReaper) Run(ctx context.Context) {
+	if reaper.Interval == 0 {
+		log.Info("session reaper is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(reaper.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reaper.Reap(ctx)
+		}
+	}
+}
+
+func (reaper *SessionReaper) Reap(ctx context.Context) {
+	var sessions, used_tokens, action_tokens int64
+	locked, err := reaper.DB.WithAdvisoryLock(reaperLockKey, func() error {
+		now := time.Now()
+		idle_before := int64(0)
+		if reaper.IdleTime > 0 {
+			idle_before = now.Add(-reaper.IdleTime).Unix()
+		}
+
+		var err error
+		sessions, err = reaper.deleteInBatches(ctx, func() (int64, error) {
+			return reaper.DB.DeleteExpiredSessions(now.Unix(), idle_before, reaper.BatchSize)
+		})
+		if err != nil {
+			return err
+		}
+
+		used_tokens, err = reaper.deleteInBatches(ctx, func() (int64, error) {
+			return reaper.DB.DeleteExpiredUsedRefresh(now.Unix(), reaper.BatchSize)
+		})
+		if err != nil {
+			return err
+		}
+
+		action_tokens, err = reaper.deleteInBatches(ctx, func() (int64, error) {
+			return reaper.DB.DeleteExpiredActionTokens(now.Unix(), reaper.BatchSize)
+		})
+		return err
+	})
+	if err != nil {
+		log.Errorf("session reaper failed after removing %v sessions, %v used refresh tokens and %v action tokens: %v", sessions, used_tokens, action_tokens, err)
+		return
+	}
+	if !locked {
+		log.Debug("session reaper is running on another replica, skipping")
+		return
+	}
+
+	log.Infof("session reaper removed %v sessions, %v used refresh tokens and %v action tokens", sessions, used_tokens, action_tokens)
+}
+
+// deleteInBatches calls delete until it removes less than a full batch
+func (reaper *SessionReaper) deleteInBatches(ctx context.Context, delete func() (int64, error)) (int64, error) {
+	total := int64(0)
+	for ctx.Err() == nil {
+		deleted, err := delete()
+		total += deleted
+		if err != nil {
+			return total, err
+		}
+		if deleted < int64(reaper.BatchSize) {
+			break
+		}
+	}
+
+	return total, nil
+}
@@ -0,0 +1,136 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"AuthService/internal/database"
+)
+
+// fakeDB has backlog of expired rows for each table, methods which reaper doesn't use are left to nil Database
+type fakeDB struct {
+	database.Database
+	locked          bool
+	sessions        int64
+	used_tokens     int64
+	action_tokens   int64
+	sessions_err    error
+	expired_before  int64
+	idle_before     int64
+	session_batches int
+}
+
+func (db *fakeDB) WithAdvisoryLock(key int64, fn func() error) (bool, error) {
+	if !db.locked {
+		return false, nil
+	}
+	return true, fn()
+}
+
+func deleteBatch(backlog *int64, batch_size int) int64 {
+	deleted := *backlog
+	if deleted > int64(batch_size) {
+		deleted = int64(batch_size)
+	}
+	*backlog -= deleted
+	return deleted
+}
+
+func (db *fakeDB) DeleteExpiredSessions(expired_before int64, idle_before int64, batch_size int) (int64, error) {
+	db.expired_before, db.idle_before = expired_before, idle_before
+	db.session_batches++
+	if db.sessions_err != nil {
+		return 0, db.sessions_err
+	}
+	return deleteBatch(&db.sessions, batch_size), nil
+}
+
+func (db *fakeDB) DeleteExpiredUsedRefresh(expired_before int64, batch_size int) (int64, error) {
+	return deleteBatch(&db.used_tokens, batch_size), nil
+}
+
+func (db *fakeDB) DeleteExpiredActionTokens(expired_before int64, batch_size int) (int64, error) {
+	return deleteBatch(&db.action_tokens, batch_size), nil
+}
+
+func TestReap(t *testing.T) {
+	db := &fakeDB{locked: true, sessions: 25, used_tokens: 10, action_tokens: 3}
+	reaper := &SessionReaper{DB: db, Interval: time.Minute, BatchSize: 10, IdleTime: time.Hour}
+
+	now := time.Now().Unix()
+	reaper.Reap(context.Background())
+
+	if db.sessions != 0 || db.used_tokens != 0 || db.action_tokens != 0 {
+		t.Fatalf("backlog left: %v sessions, %v used tokens, %v action tokens", db.sessions, db.used_tokens, db.action_tokens)
+	}
+	//25 sessions are deleted by batches of 10, 10 and 5
+	if db.session_batches != 3 {
+		t.Errorf("sessions deleted in %v batches, want 3", db.session_batches)
+	}
+	if db.expired_before < now || db.expired_before > now+1 {
+		t.Errorf("expired_before = %v, want %v", db.expired_before, now)
+	}
+	if db.idle_before != db.expired_before-3600 {
+		t.Errorf("idle_before = %v, want %v", db.idle_before, db.expired_before-3600)
+	}
+}
+
+func TestReapWithoutIdleTime(t *testing.T) {
+	db := &fakeDB{locked: true, sessions: 1}
+	reaper := &SessionReaper{DB: db, Interval: time.Minute, BatchSize: 10}
+
+	reaper.Reap(context.Background())
+	if db.idle_before != 0 {
+		t.Errorf("idle_before = %v, want idle check disabled", db.idle_before)
+	}
+}
+
+func TestReapLockedByAnotherReplica(t *testing.T) {
+	db := &fakeDB{locked: false, sessions: 5, used_tokens: 5, action_tokens: 5}
+	reaper := &SessionReaper{DB: db, Interval: time.Minute, BatchSize: 10}
+
+	reaper.Reap(context.Background())
+	if db.session_batches != 0 || db.sessions != 5 || db.used_tokens != 5 || db.action_tokens != 5 {
+		t.Errorf("reaper deleted rows without the lock")
+	}
+}
+
+func TestReapStops(t *testing.T) {
+	//failed deletion of sessions stops the whole run
+	db := &fakeDB{locked: true, sessions: 5, used_tokens: 5, sessions_err: errors.New("connection lost")}
+	reaper := &SessionReaper{DB: db, Interval: time.Minute, BatchSize: 10}
+	reaper.Reap(context.Background())
+	if db.used_tokens != 5 {
+		t.Errorf("used tokens are deleted after failure")
+	}
+
+	//canceled context stops batches, e.g. on shutdown
+	db = &fakeDB{locked: true, sessions: 100}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reaper.Reap(ctx)
+	if db.session_batches != 0 {
+		t.Errorf("%v batches deleted after cancel", db.session_batches)
+	}
+}
+
+func TestRunDisabled(t *testing.T) {
+	db := &fakeDB{locked: true, sessions: 5}
+	reaper := &SessionReaper{DB: db, Interval: 0, BatchSize: 10}
+
+	done := make(chan struct{})
+	go func() {
+		reaper.Run(context.Background())
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("Run() with zero interval doesn't return")
+	}
+	if db.session_batches != 0 {
+		t.Errorf("disabled reaper deleted sessions")
+	}
+}
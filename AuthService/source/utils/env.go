@@ -13,6 +13,11 @@ func LoadEnvFile() {
 	}
 }
 
+// ReloadEnvFile rereads .env overriding already loaded values, used for runtime reload of settings
+func ReloadEnvFile() error {
+	return godotenv.Overload()
+}
+
 func GetKeyFromEnv(key string) string {
 	secret, exists := os.LookupEnv(key)
 	if !exists {
@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -19,3 +22,10 @@ func CompareHashAndPassword(password string, hash string) error {
 
 	return err
 }
+
+// HashToken is fast digest of random token, it is used to find the row and to remember used refresh tokens.
+// It doesn't verify token: refresh is checked by HMAC of verifier, or with bcrypt for legacy sessions
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"strings"
+)
+
+type UserAgentInfo struct {
+	Browser string
+	OS      string
+	Device  string
+}
+
+type user_agent_rule struct {
+	Token string
+	Name  string
+}
+
+// order matters, most of browsers also mention Chrome and Safari in their user-agent
+var browser_rules = []user_agent_rule{
+	{"Edg/", "Edge"},
+	{"OPR/", "Opera"},
+	{"YaBrowser/", "Yandex Browser"},
+	{"Firefox/", "Firefox"},
+	{"Chrome/", "Chrome"},
+	{"Version/", "Safari"},
+	{"curl/", "curl"},
+	{"PostmanRuntime/", "Postman"},
+}
+
+var os_rules = []user_agent_rule{
+	{"Windows NT", "Windows"},
+	{"Android", "Android"},
+	{"iPhone", "iOS"},
+	{"iPad", "iOS"},
+	{"Mac OS X", "macOS"},
+	{"CrOS", "ChromeOS"},
+	{"Linux", "Linux"},
+}
+
+// ParseUserAgent gives short description of client to show it in session list, unknown values are "Other"
+func ParseUserAgent(user_agent string) UserAgentInfo {
+	info := UserAgentInfo{Browser: "Other", OS: "Other", Device: "Desktop"}
+
+	for _, rule := range browser_rules {
+		if index := strings.Index(user_agent, rule.Token); index != -1 {
+			info.Browser = rule.Name
+			if version := majorVersion(user_agent[index+len(rule.Token):]); version != "" {
+				info.Browser += " " + version
+			}
+			break
+		}
+	}
+
+	for _, rule := range os_rules {
+		if strings.Contains(user_agent, rule.Token) {
+			info.OS = rule.Name
+			break
+		}
+	}
+
+	switch {
+	case strings.Contains(user_agent, "iPad") || (info.OS == "Android" && !strings.Contains(user_agent, "Mobile")):
+		info.Device = "Tablet"
+	case strings.Contains(user_agent, "Mobi") || info.OS == "iOS" || info.OS == "Android":
+		info.Device = "Mobile"
+	case info.OS == "Other":
+		info.Device = "Other"
+	}
+
+	return info
+}
+
+func majorVersion(version string) string {
+	end := strings.IndexFunc(version, func(r rune) bool { return r < '0' || r > '9' })
+	if end == -1 {
+		return version
+	}
+
+	return version[:end]
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// SendToWebhook posts json with security event to WEBHOOK_URL
+func SendToWebhook(data map[string]string) error {
+	webhook := GetKeyFromEnv("WEBHOOK_URL")
+	json_data, err := json.Marshal(data)
+	if err != nil {
+		log.Errorf("failed to marshal webhook data: %v", err)
+		return err
+	}
+
+	response, err := http.Post(webhook, "application/json", bytes.NewBuffer(json_data))
+	if err != nil {
+		log.Errorf("failed to send to webhook: %v", err)
+		return err
+	}
+	defer response.Body.Close()
+
+	log.Print("message send to webhook")
+	return nil
+}
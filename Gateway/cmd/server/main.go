@@ -7,11 +7,15 @@ import (
 	"net/http"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	//error details from auth service are google.protobuf.Any, their types must be registered to be rendered
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"AuthService/source/utils"
 	"Gateway/internal/annotators"
+	"Gateway/internal/headers"
+	"Gateway/internal/marshalers"
 
 	pb "Proto"
 )
@@ -20,7 +24,7 @@ func allowCORS(h http.Handler) http.Handler {
     return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
         w.Header().Set("Access-Control-Allow-Origin", "*")
         w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-        w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, User-Agent, X-Forwarded-For")
+        w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, User-Agent, X-Forwarded-For, X-Admin-Key")
         if r.Method == "OPTIONS" {
             w.WriteHeader(http.StatusOK)
             return
@@ -44,7 +48,14 @@ func main(){
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux(runtime.WithMetadata(annotators.PutClientIpInMetadata), runtime.WithMetadata(annotators.PutClientUserAgentInMetadata))
+	mux := runtime.NewServeMux(
+		runtime.WithMetadata(annotators.PutClientIpInMetadata),
+		runtime.WithMetadata(annotators.PutClientUserAgentInMetadata),
+		runtime.WithMetadata(annotators.PutAdminKeyInMetadata),
+		runtime.WithOutgoingHeaderMatcher(headers.OutgoingHeaderMatcher),
+		runtime.WithForwardResponseOption(headers.ForwardHTTPCode),
+		runtime.WithMarshalerOption(marshalers.MIMEForm, marshalers.NewFormMarshaler()),
+	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	err := pb.RegisterAuthHandlerFromEndpoint(ctx, mux, grpc_auth_address, opts)
 	if err != nil{
@@ -0,0 +1,18 @@
+package annotators
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// PutAdminKeyInMetadata passes X-Admin-Key header to admin rpcs of AuthService
+func PutAdminKeyInMetadata(ctx context.Context, request *http.Request) metadata.MD {
+	admin_key := request.Header.Get("X-Admin-Key")
+	if admin_key != "" {
+		return metadata.Pairs("x-admin-key", admin_key)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package headers
+
+import (
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// OutgoingHeaderMatcher passes http headers set by AuthService as they are,
+// everything else keeps default Grpc-Metadata- prefix
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case "cache-control":
+		return "Cache-Control", true
+	case "pragma":
+		return "Pragma", true
+	case "location":
+		return "Location", true
+	case "x-http-code":
+		//applied by ForwardHTTPCode
+		return "", false
+	}
+
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
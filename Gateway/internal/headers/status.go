@@ -0,0 +1,32 @@
+package headers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+)
+
+// ForwardHTTPCode sets status of successful response from x-http-code header of AuthService.
+// It is used by OAuth endpoints for redirects and error responses defined by RFCs.
+func ForwardHTTPCode(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	values := md.HeaderMD.Get("x-http-code")
+	if len(values) == 0 {
+		return nil
+	}
+
+	code, err := strconv.Atoi(values[0])
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(code)
+
+	return nil
+}
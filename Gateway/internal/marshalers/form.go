@@ -0,0 +1,68 @@
+package marshalers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const MIMEForm = "application/x-www-form-urlencoded"
+
+// FormMarshaler decodes application/x-www-form-urlencoded bodies which are required by OAuth endpoints.
+// Responses are still written as json.
+type FormMarshaler struct {
+	runtime.HTTPBodyMarshaler
+}
+
+func NewFormMarshaler() *FormMarshaler {
+	return &FormMarshaler{HTTPBodyMarshaler: runtime.HTTPBodyMarshaler{Marshaler: &runtime.JSONPb{}}}
+}
+
+func (marshaler *FormMarshaler) NewDecoder(reader io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(value interface{}) error {
+		message, ok := value.(proto.Message)
+		if !ok {
+			return fmt.Errorf("unexpected type %T, form can be decoded only into proto message", value)
+		}
+
+		body, err := io.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return err
+		}
+
+		//form is converted to json object, so field types are handled by protojson
+		fields := message.ProtoReflect().Descriptor().Fields()
+		object := make(map[string]interface{})
+		for key, values := range form {
+			field := fields.ByName(protoreflect.Name(key))
+			if field == nil {
+				field = fields.ByJSONName(key)
+			}
+			if field == nil || len(values) == 0 {
+				continue
+			}
+
+			if field.IsList() {
+				object[string(field.Name())] = values
+			} else {
+				object[string(field.Name())] = values[0]
+			}
+		}
+
+		data, err := json.Marshal(object)
+		if err != nil {
+			return err
+		}
+		return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, message)
+	})
+}
@@ -9,9 +9,11 @@ package proto
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -26,6 +28,9 @@ const (
 type GetTokensMsg struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Guid          string                 `protobuf:"bytes,1,opt,name=guid,proto3" json:"guid,omitempty"`
+	Scope         string                 `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Nonce         string                 `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -67,6 +72,27 @@ func (x *GetTokensMsg) GetGuid() string {
 	return ""
 }
 
+func (x *GetTokensMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *GetTokensMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *GetTokensMsg) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
 type RefreshTokensMsg struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Refresh       string                 `protobuf:"bytes,1,opt,name=refresh,proto3" json:"refresh,omitempty"`
@@ -115,6 +141,8 @@ type GetTokensReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Access        string                 `protobuf:"bytes,1,opt,name=access,proto3" json:"access,omitempty"`
 	Refresh       string                 `protobuf:"bytes,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	IdToken       string                 `protobuf:"bytes,3,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	MfaChallenge  string                 `protobuf:"bytes,4,opt,name=mfa_challenge,json=mfaChallenge,proto3" json:"mfa_challenge,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -163,6 +191,20 @@ func (x *GetTokensReply) GetRefresh() string {
 	return ""
 }
 
+func (x *GetTokensReply) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *GetTokensReply) GetMfaChallenge() string {
+	if x != nil {
+		return x.MfaChallenge
+	}
+	return ""
+}
+
 type RefreshTokensReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Access        string                 `protobuf:"bytes,1,opt,name=access,proto3" json:"access,omitempty"`
@@ -303,275 +345,4703 @@ func (x *AddUserReply) GetGuid() string {
 	return ""
 }
 
-var File_Proto_auth_proto protoreflect.FileDescriptor
+type IntrospectTokenMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TokenTypeHint string                 `protobuf:"bytes,2,opt,name=token_type_hint,json=tokenTypeHint,proto3" json:"token_type_hint,omitempty"`
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_Proto_auth_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
-	0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x61, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x50, 0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x2a, 0x04, 0x47, 0x55, 0x49, 0x44, 0x32, 0x09,
-	0x55, 0x73, 0x65, 0x72, 0x20, 0x47, 0x55, 0x49, 0x44, 0x4a, 0x26, 0x22, 0x36, 0x36, 0x64, 0x38,
-	0x39, 0x62, 0x30, 0x62, 0x2d, 0x65, 0x61, 0x61, 0x65, 0x2d, 0x34, 0x38, 0x35, 0x33, 0x2d, 0x39,
-	0x30, 0x63, 0x33, 0x2d, 0x32, 0x33, 0x38, 0x64, 0x34, 0x35, 0x33, 0x31, 0x62, 0x64, 0x31, 0x61,
-	0x22, 0x52, 0x04, 0x67, 0x75, 0x69, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x79, 0x0a,
-	0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5f,
-	0x92, 0x41, 0x5c, 0x2a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x32, 0x0d, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x42, 0x22, 0x74, 0x32,
-	0x2b, 0x6b, 0x50, 0x4b, 0x43, 0x34, 0x78, 0x2b, 0x34, 0x54, 0x76, 0x76, 0x41, 0x52, 0x4f, 0x61,
-	0x5a, 0x6b, 0x4d, 0x48, 0x65, 0x61, 0x6a, 0x53, 0x43, 0x4d, 0x47, 0x41, 0x75, 0x38, 0x78, 0x56,
-	0x74, 0x63, 0x51, 0x6e, 0x58, 0x67, 0x38, 0x41, 0x53, 0x34, 0x46, 0x62, 0x31, 0x49, 0x35, 0x46,
-	0x46, 0x6e, 0x70, 0x38, 0x4c, 0x30, 0x79, 0x78, 0x2b, 0x36, 0x45, 0x44, 0x30, 0x71, 0x22, 0x52,
-	0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0xff, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0xf0, 0x01, 0x0a,
-	0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0xd7, 0x01,
-	0x92, 0x41, 0xd3, 0x01, 0x2a, 0x06, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x32, 0x0c, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0xba, 0x01, 0x22, 0x65, 0x79,
-	0x4a, 0x68, 0x62, 0x47, 0x63, 0x69, 0x4f, 0x69, 0x4a, 0x49, 0x55, 0x7a, 0x55, 0x78, 0x4d, 0x69,
-	0x49, 0x73, 0x49, 0x6e, 0x52, 0x35, 0x63, 0x43, 0x49, 0x36, 0x49, 0x6b, 0x70, 0x58, 0x56, 0x43,
-	0x4a, 0x39, 0x2e, 0x65, 0x79, 0x4a, 0x48, 0x56, 0x55, 0x6c, 0x45, 0x49, 0x6a, 0x6f, 0x69, 0x59,
-	0x57, 0x4a, 0x6a, 0x49, 0x69, 0x77, 0x69, 0x55, 0x32, 0x56, 0x7a, 0x63, 0x32, 0x6c, 0x76, 0x62,
-	0x6b, 0x6c, 0x6b, 0x49, 0x6a, 0x6f, 0x78, 0x4c, 0x43, 0x4a, 0x6c, 0x65, 0x48, 0x41, 0x69, 0x4f,
-	0x6a, 0x45, 0x33, 0x4e, 0x54, 0x45, 0x31, 0x4e, 0x44, 0x51, 0x32, 0x4e, 0x44, 0x4e, 0x39, 0x2e,
-	0x46, 0x54, 0x62, 0x73, 0x72, 0x55, 0x74, 0x78, 0x4b, 0x7a, 0x52, 0x4a, 0x73, 0x6f, 0x6c, 0x45,
-	0x42, 0x52, 0x34, 0x58, 0x66, 0x6b, 0x79, 0x69, 0x75, 0x6d, 0x6a, 0x37, 0x6e, 0x54, 0x50, 0x64,
-	0x54, 0x75, 0x43, 0x63, 0x72, 0x76, 0x78, 0x6c, 0x68, 0x41, 0x48, 0x37, 0x61, 0x46, 0x49, 0x4f,
-	0x57, 0x57, 0x5a, 0x68, 0x6a, 0x53, 0x63, 0x41, 0x6f, 0x54, 0x63, 0x47, 0x63, 0x33, 0x6a, 0x56,
-	0x6d, 0x6f, 0x53, 0x62, 0x56, 0x36, 0x50, 0x5f, 0x61, 0x71, 0x68, 0x43, 0x62, 0x55, 0x71, 0x71,
-	0x6c, 0x62, 0x50, 0x37, 0x33, 0x67, 0x22, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x79, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x5f, 0x92, 0x41, 0x5c, 0x2a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x32, 0x0d,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x42, 0x22,
-	0x74, 0x32, 0x2b, 0x6b, 0x50, 0x4b, 0x43, 0x34, 0x78, 0x2b, 0x34, 0x54, 0x76, 0x76, 0x41, 0x52,
-	0x4f, 0x61, 0x5a, 0x6b, 0x4d, 0x48, 0x65, 0x61, 0x6a, 0x53, 0x43, 0x4d, 0x47, 0x41, 0x75, 0x38,
-	0x78, 0x56, 0x74, 0x63, 0x51, 0x6e, 0x58, 0x67, 0x38, 0x41, 0x53, 0x34, 0x46, 0x62, 0x31, 0x49,
-	0x35, 0x46, 0x46, 0x6e, 0x70, 0x38, 0x4c, 0x30, 0x79, 0x78, 0x2b, 0x36, 0x45, 0x44, 0x30, 0x71,
-	0x22, 0x52, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x83, 0x03, 0x0a, 0x13, 0x52,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0xf0, 0x01, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0xd7, 0x01, 0x92, 0x41, 0xd3, 0x01, 0x2a, 0x06, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x32, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x4a, 0xba, 0x01, 0x22, 0x65, 0x79, 0x4a, 0x68, 0x62, 0x47, 0x63, 0x69, 0x4f, 0x69, 0x4a, 0x49,
-	0x55, 0x7a, 0x55, 0x78, 0x4d, 0x69, 0x49, 0x73, 0x49, 0x6e, 0x52, 0x35, 0x63, 0x43, 0x49, 0x36,
-	0x49, 0x6b, 0x70, 0x58, 0x56, 0x43, 0x4a, 0x39, 0x2e, 0x65, 0x79, 0x4a, 0x48, 0x56, 0x55, 0x6c,
-	0x45, 0x49, 0x6a, 0x6f, 0x69, 0x59, 0x57, 0x4a, 0x6a, 0x49, 0x69, 0x77, 0x69, 0x55, 0x32, 0x56,
-	0x7a, 0x63, 0x32, 0x6c, 0x76, 0x62, 0x6b, 0x6c, 0x6b, 0x49, 0x6a, 0x6f, 0x78, 0x4c, 0x43, 0x4a,
-	0x6c, 0x65, 0x48, 0x41, 0x69, 0x4f, 0x6a, 0x45, 0x33, 0x4e, 0x54, 0x45, 0x31, 0x4e, 0x44, 0x51,
-	0x32, 0x4e, 0x44, 0x4e, 0x39, 0x2e, 0x46, 0x54, 0x62, 0x73, 0x72, 0x55, 0x74, 0x78, 0x4b, 0x7a,
-	0x52, 0x4a, 0x73, 0x6f, 0x6c, 0x45, 0x42, 0x52, 0x34, 0x58, 0x66, 0x6b, 0x79, 0x69, 0x75, 0x6d,
-	0x6a, 0x37, 0x6e, 0x54, 0x50, 0x64, 0x54, 0x75, 0x43, 0x63, 0x72, 0x76, 0x78, 0x6c, 0x68, 0x41,
-	0x48, 0x37, 0x61, 0x46, 0x49, 0x4f, 0x57, 0x57, 0x5a, 0x68, 0x6a, 0x53, 0x63, 0x41, 0x6f, 0x54,
-	0x63, 0x47, 0x63, 0x33, 0x6a, 0x56, 0x6d, 0x6f, 0x53, 0x62, 0x56, 0x36, 0x50, 0x5f, 0x61, 0x71,
-	0x68, 0x43, 0x62, 0x55, 0x71, 0x71, 0x6c, 0x62, 0x50, 0x37, 0x33, 0x67, 0x22, 0x52, 0x06, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x79, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5f, 0x92, 0x41, 0x5c, 0x2a, 0x07, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x32, 0x0d, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x4a, 0x42, 0x22, 0x74, 0x32, 0x2b, 0x6b, 0x50, 0x4b, 0x43, 0x34, 0x78, 0x2b,
-	0x34, 0x54, 0x76, 0x76, 0x41, 0x52, 0x4f, 0x61, 0x5a, 0x6b, 0x4d, 0x48, 0x65, 0x61, 0x6a, 0x53,
-	0x43, 0x4d, 0x47, 0x41, 0x75, 0x38, 0x78, 0x56, 0x74, 0x63, 0x51, 0x6e, 0x58, 0x67, 0x38, 0x41,
-	0x53, 0x34, 0x46, 0x62, 0x31, 0x49, 0x35, 0x46, 0x46, 0x6e, 0x70, 0x38, 0x4c, 0x30, 0x79, 0x78,
-	0x2b, 0x36, 0x45, 0x44, 0x30, 0x71, 0x22, 0x52, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x22, 0x61, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x55, 0x49, 0x44, 0x5f, 0x72, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x50, 0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x3c, 0x92, 0x41, 0x39, 0x2a, 0x04, 0x47, 0x55, 0x49, 0x44, 0x32, 0x09, 0x55, 0x73, 0x65, 0x72,
-	0x20, 0x47, 0x55, 0x49, 0x44, 0x4a, 0x26, 0x22, 0x36, 0x36, 0x64, 0x38, 0x39, 0x62, 0x30, 0x62,
-	0x2d, 0x65, 0x61, 0x61, 0x65, 0x2d, 0x34, 0x38, 0x35, 0x33, 0x2d, 0x39, 0x30, 0x63, 0x33, 0x2d,
-	0x32, 0x33, 0x38, 0x64, 0x34, 0x35, 0x33, 0x31, 0x62, 0x64, 0x31, 0x61, 0x22, 0x52, 0x04, 0x67,
-	0x75, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x72,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x50, 0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x2a, 0x04, 0x47, 0x55, 0x49, 0x44, 0x32, 0x09, 0x55,
-	0x73, 0x65, 0x72, 0x20, 0x47, 0x55, 0x49, 0x44, 0x4a, 0x26, 0x22, 0x36, 0x36, 0x64, 0x38, 0x39,
-	0x62, 0x30, 0x62, 0x2d, 0x65, 0x61, 0x61, 0x65, 0x2d, 0x34, 0x38, 0x35, 0x33, 0x2d, 0x39, 0x30,
-	0x63, 0x33, 0x2d, 0x32, 0x33, 0x38, 0x64, 0x34, 0x35, 0x33, 0x31, 0x62, 0x64, 0x31, 0x61, 0x22,
-	0x52, 0x04, 0x67, 0x75, 0x69, 0x64, 0x32, 0xd2, 0x13, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12,
-	0xdb, 0x03, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f,
-	0x6d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9f, 0x03, 0x92, 0x41,
-	0x81, 0x03, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0a, 0x47, 0x65, 0x74, 0x20, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x1a, 0x2b, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x20, 0x74, 0x6f, 0x20,
-	0x67, 0x65, 0x74, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x69,
-	0x72, 0x4a, 0x5f, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x58, 0x22, 0x56, 0x0a, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x42,
-	0x7b, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x4a, 0x57, 0x54, 0x20,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x22,
-	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x3a, 0x20, 0x22, 0x62, 0x61, 0x73, 0x65, 0x20,
-	0x36, 0x34, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x7d, 0x4a, 0x94, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x8c, 0x01, 0x0a, 0x44, 0x49,
-	0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x20, 0x55, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x20, 0x6f, 0x72, 0x20, 0x58, 0x2d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x2d,
-	0x46, 0x6f, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0x3a, 0x20, 0x22, 0x78, 0x2d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2d,
-	0x66, 0x6f, 0x72, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x48, 0x0a, 0x03, 0x34, 0x30, 0x34,
-	0x12, 0x41, 0x0a, 0x0e, 0x47, 0x55, 0x49, 0x44, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x22, 0x2f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x3a, 0x20, 0x22, 0x47, 0x55, 0x49, 0x44, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e,
-	0x64, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0xdd, 0x05,
-	0x0a, 0x0d, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
-	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f,
-	0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x95, 0x05, 0x92, 0x41, 0xf3, 0x04, 0x0a, 0x04, 0x41, 0x75,
-	0x74, 0x68, 0x12, 0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x20, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x87, 0x01, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x69, 0x72, 0x2e, 0x20, 0x46, 0x75, 0x6e,
-	0x63, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x20, 0x69, 0x66, 0x20, 0x69, 0x70, 0x2f, 0x75, 0x73,
-	0x65, 0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
-	0x2e, 0x20, 0x49, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x20,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x6c, 0x64, 0x20, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69,
-	0x73, 0x74, 0x73, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x4a, 0x6a, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x63, 0x22, 0x61, 0x0a, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x4d, 0x7b,
-	0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x6e, 0x65, 0x77, 0x20, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x22, 0x72,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x3a, 0x20, 0x22, 0x6e, 0x65, 0x77, 0x20, 0x62, 0x61,
-	0x73, 0x65, 0x36, 0x34, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x72, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7d, 0x4a, 0x82, 0x01, 0x0a,
-	0x03, 0x34, 0x30, 0x30, 0x12, 0x7b, 0x0a, 0x33, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x20,
-	0x75, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x78,
-	0x2d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x2d, 0x66, 0x6f, 0x72, 0x20, 0x6e,
-	0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x10, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
-	0x30, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x78, 0x2d, 0x66, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2d, 0x66, 0x6f, 0x72, 0x20, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22,
-	0x7d, 0x4a, 0x73, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x6c, 0x0a, 0x3a, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20,
-	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x2e, 0x20, 0x4f, 0x72, 0x20,
-	0x75, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x20, 0x68, 0x61, 0x73, 0x20, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x2e, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x7b, 0x22, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x59, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x52, 0x0a,
-	0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x40, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
-	0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22,
-	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x7d, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x9a, 0x04,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x55, 0x49, 0x44, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x55, 0x49,
-	0x44, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xe0, 0x03, 0x92, 0x41, 0xc4, 0x03, 0x0a, 0x04,
-	0x41, 0x75, 0x74, 0x68, 0x12, 0x10, 0x47, 0x65, 0x74, 0x20, 0x47, 0x55, 0x49, 0x44, 0x20, 0x6f,
-	0x66, 0x20, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x2f, 0x54, 0x68, 0x69, 0x73, 0x20, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x75, 0x73, 0x65, 0x72,
-	0x20, 0x67, 0x75, 0x69, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x4d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x46,
-	0x22, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x7b, 0x22, 0x67, 0x75, 0x69, 0x64, 0x22, 0x3a, 0x20, 0x22,
-	0x36, 0x36, 0x64, 0x38, 0x39, 0x62, 0x30, 0x62, 0x2d, 0x65, 0x61, 0x61, 0x65, 0x2d, 0x34, 0x38,
-	0x35, 0x33, 0x2d, 0x39, 0x30, 0x63, 0x33, 0x2d, 0x32, 0x33, 0x38, 0x64, 0x34, 0x35, 0x33, 0x31,
-	0x62, 0x64, 0x31, 0x61, 0x22, 0x7d, 0x4a, 0x68, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x61, 0x0a,
-	0x18, 0x41, 0x75, 0x74, 0x68, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x6e, 0x6f, 0x74,
-	0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22, 0x45, 0x0a, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x31, 0x7b,
-	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x69,
-	0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22, 0x7d,
-	0x4a, 0x60, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x59, 0x0a, 0x20, 0x41, 0x75, 0x74, 0x68, 0x20,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x2c, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20,
-	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x35, 0x0a, 0x10, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
-	0x21, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x20, 0x69, 0x73, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
-	0x22, 0x7d, 0x4a, 0x50, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x49, 0x0a, 0x0e, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x10, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
-	0x23, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x61, 0x69, 0x6c,
-	0x65, 0x64, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x22, 0x7d, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
-	0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x67, 0x75, 0x69, 0x64, 0x12, 0xa6, 0x03, 0x0a, 0x06, 0x4c,
-	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xeb, 0x02, 0x92, 0x41, 0xd1, 0x02, 0x0a, 0x04, 0x41, 0x75,
-	0x74, 0x68, 0x12, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x20, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x1a,
-	0x3b, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x2c, 0x20, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x61,
-	0x6e, 0x64, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x1f, 0x0a, 0x03,
-	0x32, 0x30, 0x30, 0x12, 0x18, 0x22, 0x16, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x02, 0x7b, 0x7d, 0x4a, 0x65, 0x0a,
-	0x03, 0x34, 0x30, 0x31, 0x12, 0x5e, 0x0a, 0x2c, 0x41, 0x75, 0x74, 0x68, 0x20, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x2c, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74,
-	0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x6e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x64, 0x22, 0x7d, 0x4a, 0x69, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x62, 0x0a, 0x25, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x77, 0x68,
-	0x69, 0x6c, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x69, 0x74, 0x68,
-	0x20, 0x64, 0x62, 0x73, 0x22, 0x39, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x62,
-	0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x12, 0xc5, 0x02, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8b, 0x02,
-	0x92, 0x41, 0xef, 0x01, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x08, 0x41, 0x64, 0x64, 0x20,
-	0x75, 0x73, 0x65, 0x72, 0x1a, 0x29, 0x41, 0x64, 0x64, 0x73, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20,
-	0x69, 0x6e, 0x20, 0x44, 0x42, 0x2c, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x67,
-	0x75, 0x69, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x75, 0x73, 0x65, 0x72, 0x4a,
-	0x4d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x46, 0x22, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x7b, 0x22,
-	0x67, 0x75, 0x69, 0x64, 0x22, 0x3a, 0x20, 0x22, 0x36, 0x36, 0x64, 0x38, 0x39, 0x62, 0x30, 0x62,
-	0x2d, 0x65, 0x61, 0x61, 0x65, 0x2d, 0x34, 0x38, 0x35, 0x33, 0x2d, 0x39, 0x30, 0x63, 0x33, 0x2d,
-	0x32, 0x33, 0x38, 0x64, 0x34, 0x35, 0x33, 0x31, 0x62, 0x64, 0x31, 0x61, 0x22, 0x7d, 0x4a, 0x63,
-	0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x5c, 0x0a, 0x25, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x6f,
-	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x64, 0x62, 0x73, 0x22, 0x33,
-	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
-	0x6f, 0x6e, 0x12, 0x1f, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x66,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x64, 0x64, 0x20, 0x75, 0x73, 0x65,
-	0x72, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x64, 0x64, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x42, 0xf1, 0x02, 0x92, 0x41,
-	0xe5, 0x02, 0x12, 0x8c, 0x02, 0x0a, 0x10, 0x4d, 0x45, 0x44, 0x4f, 0x44, 0x53, 0x20, 0x54, 0x45,
-	0x53, 0x54, 0x20, 0x54, 0x41, 0x53, 0x4b, 0x12, 0xac, 0x01, 0xd0, 0xad, 0xd1, 0x82, 0xd0, 0xbe,
-	0x20, 0xd1, 0x82, 0xd0, 0xb5, 0xd1, 0x81, 0xd1, 0x82, 0xd0, 0xbe, 0xd0, 0xb2, 0xd0, 0xbe, 0xd0,
-	0xb5, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xb4, 0xd0, 0xb0, 0xd0, 0xbd, 0xd0, 0xb8, 0xd0, 0xb5,
-	0x20, 0xd0, 0xb2, 0xd0, 0xba, 0xd0, 0xbb, 0xd1, 0x8e, 0xd1, 0x87, 0xd0, 0xb0, 0xd0, 0xb5, 0xd1,
-	0x82, 0xd1, 0x81, 0xd1, 0x8f, 0x20, 0xd0, 0xb2, 0x20, 0xd1, 0x81, 0xd0, 0xb5, 0xd0, 0xb1, 0xd1,
-	0x8f, 0x20, 0xd0, 0xbd, 0xd0, 0xb0, 0xd0, 0xb1, 0xd0, 0xbe, 0xd1, 0x80, 0x20, 0xd1, 0x84, 0xd1,
-	0x83, 0xd0, 0xbd, 0xd0, 0xba, 0xd1, 0x86, 0xd0, 0xb8, 0xd0, 0xb9, 0x2c, 0x20, 0xd1, 0x80, 0xd0,
-	0xb5, 0xd0, 0xb0, 0xd0, 0xbb, 0xd0, 0xb8, 0xd0, 0xb7, 0xd1, 0x83, 0xd1, 0x8e, 0xd1, 0x89, 0xd0,
-	0xb8, 0xd1, 0x85, 0x20, 0xd1, 0x87, 0xd0, 0xb0, 0xd1, 0x81, 0xd1, 0x82, 0xd1, 0x8c, 0x20, 0xd1,
-	0x81, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb2, 0xd0, 0xb8, 0xd1, 0x81, 0xd0, 0xb0, 0x20, 0xd0, 0xb0,
-	0xd1, 0x83, 0xd1, 0x82, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x84, 0xd0, 0xb8, 0xd0, 0xba, 0xd0, 0xb0,
-	0xd1, 0x86, 0xd0, 0xb8, 0xd0, 0xb8, 0x22, 0x44, 0x0a, 0x0e, 0x59, 0x75, 0x6e, 0x75, 0x73, 0x6f,
-	0x76, 0x20, 0x52, 0x75, 0x73, 0x6c, 0x61, 0x6e, 0x12, 0x1c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a,
-	0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x73,
-	0x73, 0x6f, 0x63, 0x68, 0x65, 0x6b, 0x1a, 0x14, 0x72, 0x75, 0x73, 0x6c, 0x61, 0x6e, 0x79, 0x6e,
-	0x79, 0x73, 0x6f, 0x76, 0x40, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x72, 0x75, 0x32, 0x03, 0x31, 0x2e,
-	0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x2d, 0x0a, 0x2b, 0x0a, 0x06, 0x42, 0x65,
-	0x61, 0x72, 0x65, 0x72, 0x12, 0x21, 0x08, 0x02, 0x12, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x5a, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *IntrospectTokenMsg) Reset() {
+	*x = IntrospectTokenMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectTokenMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectTokenMsg) ProtoMessage() {}
+
+func (x *IntrospectTokenMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectTokenMsg.ProtoReflect.Descriptor instead.
+func (*IntrospectTokenMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IntrospectTokenMsg) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *IntrospectTokenMsg) GetTokenTypeHint() string {
+	if x != nil {
+		return x.TokenTypeHint
+	}
+	return ""
+}
+
+func (x *IntrospectTokenMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *IntrospectTokenMsg) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type RevokeTokenMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TokenTypeHint string                 `protobuf:"bytes,2,opt,name=token_type_hint,json=tokenTypeHint,proto3" json:"token_type_hint,omitempty"`
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenMsg) Reset() {
+	*x = RevokeTokenMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenMsg) ProtoMessage() {}
+
+func (x *RevokeTokenMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenMsg.ProtoReflect.Descriptor instead.
+func (*RevokeTokenMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RevokeTokenMsg) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RevokeTokenMsg) GetTokenTypeHint() string {
+	if x != nil {
+		return x.TokenTypeHint
+	}
+	return ""
+}
+
+func (x *RevokeTokenMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RevokeTokenMsg) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type SessionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    int64                  `protobuf:"varint,3,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Ip            string                 `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,6,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Browser       string                 `protobuf:"bytes,7,opt,name=browser,proto3" json:"browser,omitempty"`
+	Os            string                 `protobuf:"bytes,8,opt,name=os,proto3" json:"os,omitempty"`
+	Device        string                 `protobuf:"bytes,9,opt,name=device,proto3" json:"device,omitempty"`
+	Current       bool                   `protobuf:"varint,10,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	mi := &file_Proto_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SessionInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSessionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionInfo         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsReply) Reset() {
+	*x = ListSessionsReply{}
+	mi := &file_Proto_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsReply) ProtoMessage() {}
+
+func (x *ListSessionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsReply.ProtoReflect.Descriptor instead.
+func (*ListSessionsReply) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSessionsReply) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     uint64                 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionMsg) Reset() {
+	*x = RevokeSessionMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionMsg) ProtoMessage() {}
+
+func (x *RevokeSessionMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionMsg.ProtoReflect.Descriptor instead.
+func (*RevokeSessionMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RevokeSessionMsg) GetSessionId() uint64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type RevokeAllSessionsMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExceptCurrent bool                   `protobuf:"varint,1,opt,name=except_current,json=exceptCurrent,proto3" json:"except_current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllSessionsMsg) Reset() {
+	*x = RevokeAllSessionsMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsMsg) ProtoMessage() {}
+
+func (x *RevokeAllSessionsMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsMsg.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RevokeAllSessionsMsg) GetExceptCurrent() bool {
+	if x != nil {
+		return x.ExceptCurrent
+	}
+	return false
+}
+
+type RevokeAllSessionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revoked       uint32                 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllSessionsReply) Reset() {
+	*x = RevokeAllSessionsReply{}
+	mi := &file_Proto_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsReply) ProtoMessage() {}
+
+func (x *RevokeAllSessionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsReply.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsReply) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevokeAllSessionsReply) GetRevoked() uint32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+type RegisterMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterMsg) Reset() {
+	*x = RegisterMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterMsg) ProtoMessage() {}
+
+func (x *RegisterMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterMsg.ProtoReflect.Descriptor instead.
+func (*RegisterMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RegisterMsg) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterMsg) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegisterMsg) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RegisterReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guid          string                 `protobuf:"bytes,1,opt,name=guid,proto3" json:"guid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterReply) Reset() {
+	*x = RegisterReply{}
+	mi := &file_Proto_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterReply) ProtoMessage() {}
+
+func (x *RegisterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterReply.ProtoReflect.Descriptor instead.
+func (*RegisterReply) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RegisterReply) GetGuid() string {
+	if x != nil {
+		return x.Guid
+	}
+	return ""
+}
+
+type LoginMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Scope         string                 `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	ClientId      string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Nonce         string                 `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginMsg) Reset() {
+	*x = LoginMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginMsg) ProtoMessage() {}
+
+func (x *LoginMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginMsg.ProtoReflect.Descriptor instead.
+func (*LoginMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LoginMsg) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *LoginMsg) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LoginMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *LoginMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *LoginMsg) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type RequestPasswordResetMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetMsg) Reset() {
+	*x = RequestPasswordResetMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetMsg) ProtoMessage() {}
+
+func (x *RequestPasswordResetMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetMsg.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RequestPasswordResetMsg) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ConfirmPasswordResetMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetMsg) Reset() {
+	*x = ConfirmPasswordResetMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetMsg) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetMsg.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ConfirmPasswordResetMsg) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetMsg) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type SendVerificationEmailMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationEmailMsg) Reset() {
+	*x = SendVerificationEmailMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationEmailMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationEmailMsg) ProtoMessage() {}
+
+func (x *SendVerificationEmailMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationEmailMsg.ProtoReflect.Descriptor instead.
+func (*SendVerificationEmailMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SendVerificationEmailMsg) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type VerifyEmailMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailMsg) Reset() {
+	*x = VerifyEmailMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailMsg) ProtoMessage() {}
+
+func (x *VerifyEmailMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailMsg.ProtoReflect.Descriptor instead.
+func (*VerifyEmailMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VerifyEmailMsg) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type EnrollTOTPReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPReply) Reset() {
+	*x = EnrollTOTPReply{}
+	mi := &file_Proto_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPReply) ProtoMessage() {}
+
+func (x *EnrollTOTPReply) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPReply.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPReply) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EnrollTOTPReply) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPReply) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type ConfirmTOTPMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPMsg) Reset() {
+	*x = ConfirmTOTPMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPMsg) ProtoMessage() {}
+
+func (x *ConfirmTOTPMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPMsg.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConfirmTOTPMsg) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPReply) Reset() {
+	*x = ConfirmTOTPReply{}
+	mi := &file_Proto_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPReply) ProtoMessage() {}
+
+func (x *ConfirmTOTPReply) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPReply.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPReply) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ConfirmTOTPReply) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type VerifyMFAMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenge     string                 `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Scope         string                 `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	ClientId      string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Nonce         string                 `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMFAMsg) Reset() {
+	*x = VerifyMFAMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFAMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFAMsg) ProtoMessage() {}
+
+func (x *VerifyMFAMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFAMsg.ProtoReflect.Descriptor instead.
+func (*VerifyMFAMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VerifyMFAMsg) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *VerifyMFAMsg) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyMFAMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *VerifyMFAMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *VerifyMFAMsg) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type BeginPasskeyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ceremony      string                 `protobuf:"bytes,1,opt,name=ceremony,proto3" json:"ceremony,omitempty"`
+	Options       *structpb.Struct       `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyReply) Reset() {
+	*x = BeginPasskeyReply{}
+	mi := &file_Proto_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyReply) ProtoMessage() {}
+
+func (x *BeginPasskeyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyReply.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyReply) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BeginPasskeyReply) GetCeremony() string {
+	if x != nil {
+		return x.Ceremony
+	}
+	return ""
+}
+
+func (x *BeginPasskeyReply) GetOptions() *structpb.Struct {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type FinishPasskeyRegistrationMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ceremony      string                 `protobuf:"bytes,1,opt,name=ceremony,proto3" json:"ceremony,omitempty"`
+	Credential    *structpb.Struct       `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyRegistrationMsg) Reset() {
+	*x = FinishPasskeyRegistrationMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyRegistrationMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyRegistrationMsg) ProtoMessage() {}
+
+func (x *FinishPasskeyRegistrationMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyRegistrationMsg.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyRegistrationMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FinishPasskeyRegistrationMsg) GetCeremony() string {
+	if x != nil {
+		return x.Ceremony
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationMsg) GetCredential() *structpb.Struct {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+func (x *FinishPasskeyRegistrationMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FinishPasskeyRegistrationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyRegistrationReply) Reset() {
+	*x = FinishPasskeyRegistrationReply{}
+	mi := &file_Proto_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyRegistrationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyRegistrationReply) ProtoMessage() {}
+
+func (x *FinishPasskeyRegistrationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyRegistrationReply.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyRegistrationReply) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FinishPasskeyRegistrationReply) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FinishPasskeyLoginMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ceremony      string                 `protobuf:"bytes,1,opt,name=ceremony,proto3" json:"ceremony,omitempty"`
+	Credential    *structpb.Struct       `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+	Scope         string                 `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	ClientId      string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Nonce         string                 `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyLoginMsg) Reset() {
+	*x = FinishPasskeyLoginMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyLoginMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyLoginMsg) ProtoMessage() {}
+
+func (x *FinishPasskeyLoginMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyLoginMsg.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyLoginMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FinishPasskeyLoginMsg) GetCeremony() string {
+	if x != nil {
+		return x.Ceremony
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginMsg) GetCredential() *structpb.Struct {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+func (x *FinishPasskeyLoginMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginMsg) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type StartPasswordlessLoginMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPasswordlessLoginMsg) Reset() {
+	*x = StartPasswordlessLoginMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPasswordlessLoginMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPasswordlessLoginMsg) ProtoMessage() {}
+
+func (x *StartPasswordlessLoginMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPasswordlessLoginMsg.ProtoReflect.Descriptor instead.
+func (*StartPasswordlessLoginMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StartPasswordlessLoginMsg) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type StartPasswordlessLoginReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoginId       string                 `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPasswordlessLoginReply) Reset() {
+	*x = StartPasswordlessLoginReply{}
+	mi := &file_Proto_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPasswordlessLoginReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPasswordlessLoginReply) ProtoMessage() {}
+
+func (x *StartPasswordlessLoginReply) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPasswordlessLoginReply.ProtoReflect.Descriptor instead.
+func (*StartPasswordlessLoginReply) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StartPasswordlessLoginReply) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+type CompletePasswordlessLoginMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoginId       string                 `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Scope         string                 `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	ClientId      string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Nonce         string                 `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletePasswordlessLoginMsg) Reset() {
+	*x = CompletePasswordlessLoginMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletePasswordlessLoginMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletePasswordlessLoginMsg) ProtoMessage() {}
+
+func (x *CompletePasswordlessLoginMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletePasswordlessLoginMsg.ProtoReflect.Descriptor instead.
+func (*CompletePasswordlessLoginMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CompletePasswordlessLoginMsg) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+func (x *CompletePasswordlessLoginMsg) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CompletePasswordlessLoginMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *CompletePasswordlessLoginMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CompletePasswordlessLoginMsg) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type AuthorizeMsg struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ResponseType        string                 `protobuf:"bytes,1,opt,name=response_type,json=responseType,proto3" json:"response_type,omitempty"`
+	ClientId            string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RedirectUri         string                 `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	CodeChallenge       string                 `protobuf:"bytes,4,opt,name=code_challenge,json=codeChallenge,proto3" json:"code_challenge,omitempty"`
+	CodeChallengeMethod string                 `protobuf:"bytes,5,opt,name=code_challenge_method,json=codeChallengeMethod,proto3" json:"code_challenge_method,omitempty"`
+	State               string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Scope               string                 `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`
+	Nonce               string                 `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AuthorizeMsg) Reset() {
+	*x = AuthorizeMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeMsg) ProtoMessage() {}
+
+func (x *AuthorizeMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeMsg.ProtoReflect.Descriptor instead.
+func (*AuthorizeMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AuthorizeMsg) GetResponseType() string {
+	if x != nil {
+		return x.ResponseType
+	}
+	return ""
+}
+
+func (x *AuthorizeMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *AuthorizeMsg) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *AuthorizeMsg) GetCodeChallenge() string {
+	if x != nil {
+		return x.CodeChallenge
+	}
+	return ""
+}
+
+func (x *AuthorizeMsg) GetCodeChallengeMethod() string {
+	if x != nil {
+		return x.CodeChallengeMethod
+	}
+	return ""
+}
+
+func (x *AuthorizeMsg) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AuthorizeMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *AuthorizeMsg) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type ApproveAuthorizationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RedirectTo    string                 `protobuf:"bytes,1,opt,name=redirect_to,json=redirectTo,proto3" json:"redirect_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAuthorizationReply) Reset() {
+	*x = ApproveAuthorizationReply{}
+	mi := &file_Proto_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAuthorizationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAuthorizationReply) ProtoMessage() {}
+
+func (x *ApproveAuthorizationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAuthorizationReply.ProtoReflect.Descriptor instead.
+func (*ApproveAuthorizationReply) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ApproveAuthorizationReply) GetRedirectTo() string {
+	if x != nil {
+		return x.RedirectTo
+	}
+	return ""
+}
+
+type TokenMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GrantType     string                 `protobuf:"bytes,1,opt,name=grant_type,json=grantType,proto3" json:"grant_type,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	RedirectUri   string                 `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	ClientId      string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	CodeVerifier  string                 `protobuf:"bytes,5,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,6,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,7,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenMsg) Reset() {
+	*x = TokenMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenMsg) ProtoMessage() {}
+
+func (x *TokenMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenMsg.ProtoReflect.Descriptor instead.
+func (*TokenMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TokenMsg) GetGrantType() string {
+	if x != nil {
+		return x.GrantType
+	}
+	return ""
+}
+
+func (x *TokenMsg) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TokenMsg) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *TokenMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *TokenMsg) GetCodeVerifier() string {
+	if x != nil {
+		return x.CodeVerifier
+	}
+	return ""
+}
+
+func (x *TokenMsg) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *TokenMsg) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type CreateClientMsg struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClientId        string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Confidential    bool                   `protobuf:"varint,2,opt,name=confidential,proto3" json:"confidential,omitempty"`
+	GrantTypes      []string               `protobuf:"bytes,3,rep,name=grant_types,json=grantTypes,proto3" json:"grant_types,omitempty"`
+	RedirectUris    []string               `protobuf:"bytes,4,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	Scopes          []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	AccessLifeTime  int64                  `protobuf:"varint,6,opt,name=access_life_time,json=accessLifeTime,proto3" json:"access_life_time,omitempty"`
+	RefreshLifeTime int64                  `protobuf:"varint,7,opt,name=refresh_life_time,json=refreshLifeTime,proto3" json:"refresh_life_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateClientMsg) Reset() {
+	*x = CreateClientMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClientMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClientMsg) ProtoMessage() {}
+
+func (x *CreateClientMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClientMsg.ProtoReflect.Descriptor instead.
+func (*CreateClientMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateClientMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CreateClientMsg) GetConfidential() bool {
+	if x != nil {
+		return x.Confidential
+	}
+	return false
+}
+
+func (x *CreateClientMsg) GetGrantTypes() []string {
+	if x != nil {
+		return x.GrantTypes
+	}
+	return nil
+}
+
+func (x *CreateClientMsg) GetRedirectUris() []string {
+	if x != nil {
+		return x.RedirectUris
+	}
+	return nil
+}
+
+func (x *CreateClientMsg) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateClientMsg) GetAccessLifeTime() int64 {
+	if x != nil {
+		return x.AccessLifeTime
+	}
+	return 0
+}
+
+func (x *CreateClientMsg) GetRefreshLifeTime() int64 {
+	if x != nil {
+		return x.RefreshLifeTime
+	}
+	return 0
+}
+
+type UpdateClientMsg struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClientId        string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	GrantTypes      []string               `protobuf:"bytes,2,rep,name=grant_types,json=grantTypes,proto3" json:"grant_types,omitempty"`
+	RedirectUris    []string               `protobuf:"bytes,3,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	Scopes          []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	AccessLifeTime  int64                  `protobuf:"varint,5,opt,name=access_life_time,json=accessLifeTime,proto3" json:"access_life_time,omitempty"`
+	RefreshLifeTime int64                  `protobuf:"varint,6,opt,name=refresh_life_time,json=refreshLifeTime,proto3" json:"refresh_life_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateClientMsg) Reset() {
+	*x = UpdateClientMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClientMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClientMsg) ProtoMessage() {}
+
+func (x *UpdateClientMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClientMsg.ProtoReflect.Descriptor instead.
+func (*UpdateClientMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateClientMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *UpdateClientMsg) GetGrantTypes() []string {
+	if x != nil {
+		return x.GrantTypes
+	}
+	return nil
+}
+
+func (x *UpdateClientMsg) GetRedirectUris() []string {
+	if x != nil {
+		return x.RedirectUris
+	}
+	return nil
+}
+
+func (x *UpdateClientMsg) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *UpdateClientMsg) GetAccessLifeTime() int64 {
+	if x != nil {
+		return x.AccessLifeTime
+	}
+	return 0
+}
+
+func (x *UpdateClientMsg) GetRefreshLifeTime() int64 {
+	if x != nil {
+		return x.RefreshLifeTime
+	}
+	return 0
+}
+
+type RotateClientSecretMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateClientSecretMsg) Reset() {
+	*x = RotateClientSecretMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateClientSecretMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateClientSecretMsg) ProtoMessage() {}
+
+func (x *RotateClientSecretMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateClientSecretMsg.ProtoReflect.Descriptor instead.
+func (*RotateClientSecretMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RotateClientSecretMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type DisableClientMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableClientMsg) Reset() {
+	*x = DisableClientMsg{}
+	mi := &file_Proto_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableClientMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableClientMsg) ProtoMessage() {}
+
+func (x *DisableClientMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableClientMsg.ProtoReflect.Descriptor instead.
+func (*DisableClientMsg) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DisableClientMsg) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type ClientReply struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClientId        string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret    string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Confidential    bool                   `protobuf:"varint,3,opt,name=confidential,proto3" json:"confidential,omitempty"`
+	GrantTypes      []string               `protobuf:"bytes,4,rep,name=grant_types,json=grantTypes,proto3" json:"grant_types,omitempty"`
+	RedirectUris    []string               `protobuf:"bytes,5,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	Scopes          []string               `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	AccessLifeTime  int64                  `protobuf:"varint,7,opt,name=access_life_time,json=accessLifeTime,proto3" json:"access_life_time,omitempty"`
+	RefreshLifeTime int64                  `protobuf:"varint,8,opt,name=refresh_life_time,json=refreshLifeTime,proto3" json:"refresh_life_time,omitempty"`
+	Disabled        bool                   `protobuf:"varint,9,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ClientReply) Reset() {
+	*x = ClientReply{}
+	mi := &file_Proto_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientReply) ProtoMessage() {}
+
+func (x *ClientReply) ProtoReflect() protoreflect.Message {
+	mi := &file_Proto_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientReply.ProtoReflect.Descriptor instead.
+func (*ClientReply) Descriptor() ([]byte, []int) {
+	return file_Proto_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ClientReply) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ClientReply) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *ClientReply) GetConfidential() bool {
+	if x != nil {
+		return x.Confidential
+	}
+	return false
+}
+
+func (x *ClientReply) GetGrantTypes() []string {
+	if x != nil {
+		return x.GrantTypes
+	}
+	return nil
+}
+
+func (x *ClientReply) GetRedirectUris() []string {
+	if x != nil {
+		return x.RedirectUris
+	}
+	return nil
+}
+
+func (x *ClientReply) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ClientReply) GetAccessLifeTime() int64 {
+	if x != nil {
+		return x.AccessLifeTime
+	}
+	return 0
+}
+
+func (x *ClientReply) GetRefreshLifeTime() int64 {
+	if x != nil {
+		return x.RefreshLifeTime
+	}
+	return 0
+}
+
+func (x *ClientReply) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *ClientReply) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+var File_Proto_auth_proto protoreflect.FileDescriptor
+
+var file_Proto_auth_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x62, 0x6f, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x03,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x12,
+	0x50, 0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0x92,
+	0x41, 0x39, 0x2a, 0x04, 0x47, 0x55, 0x49, 0x44, 0x32, 0x09, 0x55, 0x73, 0x65, 0x72, 0x20, 0x47,
+	0x55, 0x49, 0x44, 0x4a, 0x26, 0x22, 0x36, 0x36, 0x64, 0x38, 0x39, 0x62, 0x30, 0x62, 0x2d, 0x65,
+	0x61, 0x61, 0x65, 0x2d, 0x34, 0x38, 0x35, 0x33, 0x2d, 0x39, 0x30, 0x63, 0x33, 0x2d, 0x32, 0x33,
+	0x38, 0x64, 0x34, 0x35, 0x33, 0x31, 0x62, 0x64, 0x31, 0x61, 0x22, 0x52, 0x04, 0x67, 0x75, 0x69,
+	0x64, 0x12, 0x6c, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x56, 0x92, 0x41, 0x53, 0x2a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x40, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x20, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x2e, 0x20, 0x57, 0x69, 0x74, 0x68, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x69, 0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x6f, 0x4a, 0x08,
+	0x22, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x22, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x88, 0x01, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x6b, 0x92, 0x41, 0x68, 0x2a, 0x09, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x20, 0x49, 0x44, 0x32, 0x50, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68, 0x69, 0x63,
+	0x68, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x49, 0x44, 0x20, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x69, 0x74, 0x73,
+	0x20, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x20, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x20,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x4a, 0x09, 0x22, 0x77, 0x65, 0x62, 0x2d, 0x61, 0x70, 0x70, 0x22,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0x92, 0x41, 0x40, 0x2a, 0x05,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x32, 0x27, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x63, 0x6f, 0x70,
+	0x69, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x20, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x20, 0x6f, 0x66, 0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x0e,
+	0x22, 0x6e, 0x2d, 0x30, 0x53, 0x36, 0x5f, 0x57, 0x7a, 0x41, 0x32, 0x4d, 0x6a, 0x22, 0x52, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x90, 0x01, 0x0a, 0x07,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x76, 0x92,
+	0x41, 0x73, 0x2a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x32, 0x0d, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x59, 0x22, 0x41, 0x64, 0x58,
+	0x45, 0x4f, 0x36, 0x54, 0x6e, 0x67, 0x52, 0x52, 0x69, 0x2d, 0x6b, 0x4d, 0x46, 0x2d, 0x68, 0x46,
+	0x77, 0x47, 0x77, 0x2e, 0x75, 0x74, 0x4c, 0x46, 0x51, 0x73, 0x65, 0x72, 0x57, 0x6e, 0x33, 0x78,
+	0x7a, 0x77, 0x6b, 0x54, 0x59, 0x38, 0x36, 0x45, 0x4e, 0x43, 0x35, 0x43, 0x6c, 0x79, 0x4c, 0x32,
+	0x47, 0x45, 0x38, 0x55, 0x43, 0x73, 0x56, 0x49, 0x58, 0x35, 0x30, 0x44, 0x42, 0x41, 0x4e, 0x76,
+	0x37, 0x53, 0x37, 0x68, 0x45, 0x52, 0x74, 0x44, 0x36, 0x52, 0x41, 0x6f, 0x63, 0x6c, 0x74, 0x6c,
+	0x34, 0x5f, 0x6c, 0x55, 0x22, 0x52, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0xf7,
+	0x07, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0xf0, 0x01, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0xd7, 0x01, 0x92, 0x41, 0xd3, 0x01, 0x2a, 0x06, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x32, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4a, 0xba, 0x01, 0x22, 0x65, 0x79, 0x4a, 0x68, 0x62, 0x47, 0x63, 0x69, 0x4f, 0x69, 0x4a, 0x49,
+	0x55, 0x7a, 0x55, 0x78, 0x4d, 0x69, 0x49, 0x73, 0x49, 0x6e, 0x52, 0x35, 0x63, 0x43, 0x49, 0x36,
+	0x49, 0x6b, 0x70, 0x58, 0x56, 0x43, 0x4a, 0x39, 0x2e, 0x65, 0x79, 0x4a, 0x48, 0x56, 0x55, 0x6c,
+	0x45, 0x49, 0x6a, 0x6f, 0x69, 0x59, 0x57, 0x4a, 0x6a, 0x49, 0x69, 0x77, 0x69, 0x55, 0x32, 0x56,
+	0x7a, 0x63, 0x32, 0x6c, 0x76, 0x62, 0x6b, 0x6c, 0x6b, 0x49, 0x6a, 0x6f, 0x78, 0x4c, 0x43, 0x4a,
+	0x6c, 0x65, 0x48, 0x41, 0x69, 0x4f, 0x6a, 0x45, 0x33, 0x4e, 0x54, 0x45, 0x31, 0x4e, 0x44, 0x51,
+	0x32, 0x4e, 0x44, 0x4e, 0x39, 0x2e, 0x46, 0x54, 0x62, 0x73, 0x72, 0x55, 0x74, 0x78, 0x4b, 0x7a,
+	0x52, 0x4a, 0x73, 0x6f, 0x6c, 0x45, 0x42, 0x52, 0x34, 0x58, 0x66, 0x6b, 0x79, 0x69, 0x75, 0x6d,
+	0x6a, 0x37, 0x6e, 0x54, 0x50, 0x64, 0x54, 0x75, 0x43, 0x63, 0x72, 0x76, 0x78, 0x6c, 0x68, 0x41,
+	0x48, 0x37, 0x61, 0x46, 0x49, 0x4f, 0x57, 0x57, 0x5a, 0x68, 0x6a, 0x53, 0x63, 0x41, 0x6f, 0x54,
+	0x63, 0x47, 0x63, 0x33, 0x6a, 0x56, 0x6d, 0x6f, 0x53, 0x62, 0x56, 0x36, 0x50, 0x5f, 0x61, 0x71,
+	0x68, 0x43, 0x62, 0x55, 0x71, 0x71, 0x6c, 0x62, 0x50, 0x37, 0x33, 0x67, 0x22, 0x52, 0x06, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x90, 0x01, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x76, 0x92, 0x41, 0x73, 0x2a, 0x07, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x32, 0x0d, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x59, 0x22, 0x41, 0x64, 0x58, 0x45, 0x4f, 0x36, 0x54, 0x6e, 0x67,
+	0x52, 0x52, 0x69, 0x2d, 0x6b, 0x4d, 0x46, 0x2d, 0x68, 0x46, 0x77, 0x47, 0x77, 0x2e, 0x75, 0x74,
+	0x4c, 0x46, 0x51, 0x73, 0x65, 0x72, 0x57, 0x6e, 0x33, 0x78, 0x7a, 0x77, 0x6b, 0x54, 0x59, 0x38,
+	0x36, 0x45, 0x4e, 0x43, 0x35, 0x43, 0x6c, 0x79, 0x4c, 0x32, 0x47, 0x45, 0x38, 0x55, 0x43, 0x73,
+	0x56, 0x49, 0x58, 0x35, 0x30, 0x44, 0x42, 0x41, 0x4e, 0x76, 0x37, 0x53, 0x37, 0x68, 0x45, 0x52,
+	0x74, 0x44, 0x36, 0x52, 0x41, 0x6f, 0x63, 0x6c, 0x74, 0x6c, 0x34, 0x5f, 0x6c, 0x55, 0x22, 0x52,
+	0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0xfb, 0x02, 0x0a, 0x08, 0x69, 0x64, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0xdf, 0x02, 0x92, 0x41,
+	0xdb, 0x02, 0x2a, 0x08, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x43, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x44, 0x20, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x20, 0x49, 0x44, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x6f, 0x6e,
+	0x6c, 0x79, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x20, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x4a, 0x89, 0x02, 0x22, 0x65, 0x79, 0x4a, 0x68, 0x62, 0x47, 0x63, 0x69, 0x4f, 0x69, 0x4a,
+	0x53, 0x55, 0x7a, 0x49, 0x31, 0x4e, 0x69, 0x49, 0x73, 0x49, 0x6d, 0x74, 0x70, 0x5a, 0x43, 0x49,
+	0x36, 0x49, 0x6c, 0x39, 0x4e, 0x64, 0x6d, 0x64, 0x55, 0x63, 0x6a, 0x42, 0x45, 0x4c, 0x55, 0x52,
+	0x56, 0x62, 0x31, 0x68, 0x6b, 0x4f, 0x56, 0x46, 0x33, 0x57, 0x55, 0x4e, 0x75, 0x63, 0x31, 0x70,
+	0x4a, 0x57, 0x46, 0x46, 0x4e, 0x51, 0x56, 0x4e, 0x4e, 0x4d, 0x6b, 0x5a, 0x6e, 0x5a, 0x46, 0x42,
+	0x4c, 0x53, 0x33, 0x6b, 0x7a, 0x55, 0x56, 0x42, 0x61, 0x4e, 0x48, 0x63, 0x69, 0x4c, 0x43, 0x4a,
+	0x30, 0x65, 0x58, 0x41, 0x69, 0x4f, 0x69, 0x4a, 0x4b, 0x56, 0x31, 0x51, 0x69, 0x66, 0x51, 0x2e,
+	0x65, 0x79, 0x4a, 0x68, 0x64, 0x57, 0x51, 0x69, 0x4f, 0x69, 0x4a, 0x33, 0x5a, 0x57, 0x49, 0x74,
+	0x59, 0x58, 0x42, 0x77, 0x49, 0x69, 0x77, 0x69, 0x5a, 0x58, 0x68, 0x77, 0x49, 0x6a, 0x6f, 0x78,
+	0x4e, 0x7a, 0x55, 0x78, 0x4e, 0x54, 0x51, 0x30, 0x4e, 0x6a, 0x51, 0x7a, 0x4c, 0x43, 0x4a, 0x70,
+	0x63, 0x33, 0x4d, 0x69, 0x4f, 0x69, 0x4a, 0x6f, 0x64, 0x48, 0x52, 0x77, 0x4f, 0x69, 0x38, 0x76,
+	0x62, 0x47, 0x39, 0x6a, 0x59, 0x57, 0x78, 0x6f, 0x62, 0x33, 0x4e, 0x30, 0x4f, 0x6a, 0x67, 0x34,
+	0x4f, 0x44, 0x41, 0x69, 0x4c, 0x43, 0x4a, 0x7a, 0x64, 0x57, 0x49, 0x69, 0x4f, 0x69, 0x49, 0x32,
+	0x4e, 0x6d, 0x51, 0x34, 0x4f, 0x57, 0x49, 0x77, 0x59, 0x69, 0x31, 0x6c, 0x59, 0x57, 0x46, 0x6c,
+	0x4c, 0x54, 0x51, 0x34, 0x4e, 0x54, 0x4d, 0x74, 0x4f, 0x54, 0x42, 0x6a, 0x4d, 0x79, 0x30, 0x79,
+	0x4d, 0x7a, 0x68, 0x6b, 0x4e, 0x44, 0x55, 0x7a, 0x4d, 0x57, 0x4a, 0x6b, 0x4d, 0x57, 0x45, 0x69,
+	0x66, 0x51, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x52, 0x07, 0x69,
+	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0xdf, 0x01, 0x0a, 0x0d, 0x6d, 0x66, 0x61, 0x5f, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0xb9,
+	0x01, 0x92, 0x41, 0xb5, 0x01, 0x2a, 0x0d, 0x4d, 0x46, 0x41, 0x20, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x32, 0x5e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x62,
+	0x79, 0x20, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x75,
+	0x73, 0x65, 0x72, 0x20, 0x68, 0x61, 0x73, 0x20, 0x54, 0x4f, 0x54, 0x50, 0x20, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x2c, 0x20, 0x70, 0x61, 0x73, 0x73, 0x20, 0x69, 0x74, 0x20, 0x74, 0x6f,
+	0x20, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x46, 0x41, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+	0x63, 0x6f, 0x64, 0x65, 0x4a, 0x44, 0x22, 0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51,
+	0x32, 0x6d, 0x58, 0x31, 0x72, 0x32, 0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x2e, 0x38, 0x63,
+	0x34, 0x51, 0x62, 0x57, 0x31, 0x6e, 0x33, 0x6f, 0x59, 0x74, 0x39, 0x48, 0x6b, 0x32, 0x73, 0x56,
+	0x64, 0x37, 0x72, 0x45, 0x36, 0x75, 0x4c, 0x35, 0x6d, 0x5a, 0x30, 0x61, 0x50, 0x71, 0x34, 0x78,
+	0x4a, 0x66, 0x47, 0x68, 0x4e, 0x73, 0x31, 0x43, 0x77, 0x22, 0x52, 0x0c, 0x6d, 0x66, 0x61, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x9b, 0x03, 0x0a, 0x13, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0xf0, 0x01, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0xd7, 0x01, 0x92, 0x41, 0xd3, 0x01, 0x2a, 0x06, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x32, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0xba,
+	0x01, 0x22, 0x65, 0x79, 0x4a, 0x68, 0x62, 0x47, 0x63, 0x69, 0x4f, 0x69, 0x4a, 0x49, 0x55, 0x7a,
+	0x55, 0x78, 0x4d, 0x69, 0x49, 0x73, 0x49, 0x6e, 0x52, 0x35, 0x63, 0x43, 0x49, 0x36, 0x49, 0x6b,
+	0x70, 0x58, 0x56, 0x43, 0x4a, 0x39, 0x2e, 0x65, 0x79, 0x4a, 0x48, 0x56, 0x55, 0x6c, 0x45, 0x49,
+	0x6a, 0x6f, 0x69, 0x59, 0x57, 0x4a, 0x6a, 0x49, 0x69, 0x77, 0x69, 0x55, 0x32, 0x56, 0x7a, 0x63,
+	0x32, 0x6c, 0x76, 0x62, 0x6b, 0x6c, 0x6b, 0x49, 0x6a, 0x6f, 0x78, 0x4c, 0x43, 0x4a, 0x6c, 0x65,
+	0x48, 0x41, 0x69, 0x4f, 0x6a, 0x45, 0x33, 0x4e, 0x54, 0x45, 0x31, 0x4e, 0x44, 0x51, 0x32, 0x4e,
+	0x44, 0x4e, 0x39, 0x2e, 0x46, 0x54, 0x62, 0x73, 0x72, 0x55, 0x74, 0x78, 0x4b, 0x7a, 0x52, 0x4a,
+	0x73, 0x6f, 0x6c, 0x45, 0x42, 0x52, 0x34, 0x58, 0x66, 0x6b, 0x79, 0x69, 0x75, 0x6d, 0x6a, 0x37,
+	0x6e, 0x54, 0x50, 0x64, 0x54, 0x75, 0x43, 0x63, 0x72, 0x76, 0x78, 0x6c, 0x68, 0x41, 0x48, 0x37,
+	0x61, 0x46, 0x49, 0x4f, 0x57, 0x57, 0x5a, 0x68, 0x6a, 0x53, 0x63, 0x41, 0x6f, 0x54, 0x63, 0x47,
+	0x63, 0x33, 0x6a, 0x56, 0x6d, 0x6f, 0x53, 0x62, 0x56, 0x36, 0x50, 0x5f, 0x61, 0x71, 0x68, 0x43,
+	0x62, 0x55, 0x71, 0x71, 0x6c, 0x62, 0x50, 0x37, 0x33, 0x67, 0x22, 0x52, 0x06, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x90, 0x01, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x76, 0x92, 0x41, 0x73, 0x2a, 0x07, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x32, 0x0d, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4a, 0x59, 0x22, 0x41, 0x64, 0x58, 0x45, 0x4f, 0x36, 0x54, 0x6e, 0x67, 0x52, 0x52,
+	0x69, 0x2d, 0x6b, 0x4d, 0x46, 0x2d, 0x68, 0x46, 0x77, 0x47, 0x77, 0x2e, 0x75, 0x74, 0x4c, 0x46,
+	0x51, 0x73, 0x65, 0x72, 0x57, 0x6e, 0x33, 0x78, 0x7a, 0x77, 0x6b, 0x54, 0x59, 0x38, 0x36, 0x45,
+	0x4e, 0x43, 0x35, 0x43, 0x6c, 0x79, 0x4c, 0x32, 0x47, 0x45, 0x38, 0x55, 0x43, 0x73, 0x56, 0x49,
+	0x58, 0x35, 0x30, 0x44, 0x42, 0x41, 0x4e, 0x76, 0x37, 0x53, 0x37, 0x68, 0x45, 0x52, 0x74, 0x44,
+	0x36, 0x52, 0x41, 0x6f, 0x63, 0x6c, 0x74, 0x6c, 0x34, 0x5f, 0x6c, 0x55, 0x22, 0x52, 0x07, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x61, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x55, 0x49,
+	0x44, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x50, 0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x2a, 0x04, 0x47, 0x55, 0x49, 0x44,
+	0x32, 0x09, 0x55, 0x73, 0x65, 0x72, 0x20, 0x47, 0x55, 0x49, 0x44, 0x4a, 0x26, 0x22, 0x36, 0x36,
+	0x64, 0x38, 0x39, 0x62, 0x30, 0x62, 0x2d, 0x65, 0x61, 0x61, 0x65, 0x2d, 0x34, 0x38, 0x35, 0x33,
+	0x2d, 0x39, 0x30, 0x63, 0x33, 0x2d, 0x32, 0x33, 0x38, 0x64, 0x34, 0x35, 0x33, 0x31, 0x62, 0x64,
+	0x31, 0x61, 0x22, 0x52, 0x04, 0x67, 0x75, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x0d, 0x41, 0x64, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x50, 0x0a, 0x04, 0x67, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x2a, 0x04, 0x47,
+	0x55, 0x49, 0x44, 0x32, 0x09, 0x55, 0x73, 0x65, 0x72, 0x20, 0x47, 0x55, 0x49, 0x44, 0x4a, 0x26,
+	0x22, 0x36, 0x36, 0x64, 0x38, 0x39, 0x62, 0x30, 0x62, 0x2d, 0x65, 0x61, 0x61, 0x65, 0x2d, 0x34,
+	0x38, 0x35, 0x33, 0x2d, 0x39, 0x30, 0x63, 0x33, 0x2d, 0x32, 0x33, 0x38, 0x64, 0x34, 0x35, 0x33,
+	0x31, 0x62, 0x64, 0x31, 0x61, 0x22, 0x52, 0x04, 0x67, 0x75, 0x69, 0x64, 0x22, 0xd6, 0x05, 0x0a,
+	0x13, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x6d, 0x73, 0x67, 0x12, 0x8b, 0x02, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0xf4, 0x01, 0x92, 0x41, 0xf0, 0x01, 0x2a, 0x05, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x32, 0x2a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x69, 0x6e, 0x74,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x2c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
+	0x20, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4a, 0xba,
+	0x01, 0x22, 0x65, 0x79, 0x4a, 0x68, 0x62, 0x47, 0x63, 0x69, 0x4f, 0x69, 0x4a, 0x49, 0x55, 0x7a,
+	0x55, 0x78, 0x4d, 0x69, 0x49, 0x73, 0x49, 0x6e, 0x52, 0x35, 0x63, 0x43, 0x49, 0x36, 0x49, 0x6b,
+	0x70, 0x58, 0x56, 0x43, 0x4a, 0x39, 0x2e, 0x65, 0x79, 0x4a, 0x48, 0x56, 0x55, 0x6c, 0x45, 0x49,
+	0x6a, 0x6f, 0x69, 0x59, 0x57, 0x4a, 0x6a, 0x49, 0x69, 0x77, 0x69, 0x55, 0x32, 0x56, 0x7a, 0x63,
+	0x32, 0x6c, 0x76, 0x62, 0x6b, 0x6c, 0x6b, 0x49, 0x6a, 0x6f, 0x78, 0x4c, 0x43, 0x4a, 0x6c, 0x65,
+	0x48, 0x41, 0x69, 0x4f, 0x6a, 0x45, 0x33, 0x4e, 0x54, 0x45, 0x31, 0x4e, 0x44, 0x51, 0x32, 0x4e,
+	0x44, 0x4e, 0x39, 0x2e, 0x46, 0x54, 0x62, 0x73, 0x72, 0x55, 0x74, 0x78, 0x4b, 0x7a, 0x52, 0x4a,
+	0x73, 0x6f, 0x6c, 0x45, 0x42, 0x52, 0x34, 0x58, 0x66, 0x6b, 0x79, 0x69, 0x75, 0x6d, 0x6a, 0x37,
+	0x6e, 0x54, 0x50, 0x64, 0x54, 0x75, 0x43, 0x63, 0x72, 0x76, 0x78, 0x6c, 0x68, 0x41, 0x48, 0x37,
+	0x61, 0x46, 0x49, 0x4f, 0x57, 0x57, 0x5a, 0x68, 0x6a, 0x53, 0x63, 0x41, 0x6f, 0x54, 0x63, 0x47,
+	0x63, 0x33, 0x6a, 0x56, 0x6d, 0x6f, 0x53, 0x62, 0x56, 0x36, 0x50, 0x5f, 0x61, 0x71, 0x68, 0x43,
+	0x62, 0x55, 0x71, 0x71, 0x6c, 0x62, 0x50, 0x37, 0x33, 0x67, 0x22, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x6b, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0x92, 0x41, 0x40,
+	0x2a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x68, 0x69, 0x6e,
+	0x74, 0x32, 0x1d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20,
+	0x6f, 0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4a, 0x0e, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x12,
+	0x90, 0x01, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x73, 0x92, 0x41, 0x70, 0x2a, 0x09, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x20, 0x49, 0x44, 0x32, 0x50, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68, 0x69, 0x63,
+	0x68, 0x20, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x73, 0x20, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x42, 0x61, 0x73, 0x69, 0x63,
+	0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x4a, 0x11, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0xb0, 0x01, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x8a, 0x01, 0x92, 0x41, 0x86,
+	0x01, 0x2a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x32, 0x46, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x74, 0x20, 0x69,
+	0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x42, 0x61,
+	0x73, 0x69, 0x63, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4a, 0x2d, 0x22, 0x71, 0x38, 0x59, 0x62, 0x31,
+	0x6e, 0x58, 0x32, 0x6b, 0x44, 0x34, 0x73, 0x56, 0x36, 0x6d, 0x5a, 0x30, 0x70, 0x4c, 0x33, 0x74,
+	0x52, 0x37, 0x77, 0x45, 0x39, 0x75, 0x4a, 0x35, 0x68, 0x47, 0x66, 0x31, 0x63, 0x41, 0x32, 0x62,
+	0x4e, 0x34, 0x78, 0x4b, 0x38, 0x6f, 0x22, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x86, 0x05, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0xb7, 0x01, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0xa0, 0x01, 0x92, 0x41, 0x9c, 0x01,
+	0x2a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x38, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20,
+	0x6f, 0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x2c, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x6f, 0x75, 0x74, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x4a, 0x59, 0x22, 0x41, 0x64, 0x58, 0x45, 0x4f, 0x36, 0x54, 0x6e, 0x67, 0x52, 0x52, 0x69,
+	0x2d, 0x6b, 0x4d, 0x46, 0x2d, 0x68, 0x46, 0x77, 0x47, 0x77, 0x2e, 0x75, 0x74, 0x4c, 0x46, 0x51,
+	0x73, 0x65, 0x72, 0x57, 0x6e, 0x33, 0x78, 0x7a, 0x77, 0x6b, 0x54, 0x59, 0x38, 0x36, 0x45, 0x4e,
+	0x43, 0x35, 0x43, 0x6c, 0x79, 0x4c, 0x32, 0x47, 0x45, 0x38, 0x55, 0x43, 0x73, 0x56, 0x49, 0x58,
+	0x35, 0x30, 0x44, 0x42, 0x41, 0x4e, 0x76, 0x37, 0x53, 0x37, 0x68, 0x45, 0x52, 0x74, 0x44, 0x36,
+	0x52, 0x41, 0x6f, 0x63, 0x6c, 0x74, 0x6c, 0x34, 0x5f, 0x6c, 0x55, 0x22, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x6c, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0x92, 0x41,
+	0x41, 0x2a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x68, 0x69,
+	0x6e, 0x74, 0x32, 0x1d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4a, 0x0f, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x48, 0x69, 0x6e,
+	0x74, 0x12, 0x8e, 0x01, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x71, 0x92, 0x41, 0x6e, 0x2a, 0x09, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x20, 0x49, 0x44, 0x32, 0x56, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68,
+	0x69, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x77, 0x61,
+	0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x77, 0x68, 0x65, 0x6e,
+	0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20,
+	0x69, 0x6e, 0x20, 0x42, 0x61, 0x73, 0x69, 0x63, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4a, 0x09, 0x22,
+	0x77, 0x65, 0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0xb9, 0x01, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x93, 0x01, 0x92, 0x41, 0x8f,
+	0x01, 0x2a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x32, 0x4f, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20,
+	0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73,
+	0x65, 0x6e, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x42, 0x61, 0x73, 0x69, 0x63, 0x20, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x4a, 0x2d, 0x22, 0x71, 0x38, 0x59, 0x62, 0x31, 0x6e, 0x58, 0x32, 0x6b, 0x44, 0x34, 0x73,
+	0x56, 0x36, 0x6d, 0x5a, 0x30, 0x70, 0x4c, 0x33, 0x74, 0x52, 0x37, 0x77, 0x45, 0x39, 0x75, 0x4a,
+	0x35, 0x68, 0x47, 0x66, 0x31, 0x63, 0x41, 0x32, 0x62, 0x4e, 0x34, 0x78, 0x4b, 0x38, 0x6f, 0x22,
+	0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xd3,
+	0x07, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12,
+	0x29, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0x92, 0x41, 0x16,
+	0x2a, 0x02, 0x49, 0x44, 0x32, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x64,
+	0x4a, 0x04, 0x22, 0x31, 0x32, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x6a, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x4b,
+	0x92, 0x41, 0x48, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x61, 0x74, 0x32,
+	0x2c, 0x55, 0x6e, 0x69, 0x78, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x0c, 0x22,
+	0x31, 0x37, 0x35, 0x31, 0x35, 0x34, 0x30, 0x30, 0x30, 0x30, 0x22, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x60, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3e, 0x92, 0x41,
+	0x3b, 0x2a, 0x0c, 0x4c, 0x61, 0x73, 0x74, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x61, 0x74, 0x32,
+	0x1d, 0x55, 0x6e, 0x69, 0x78, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a, 0x0c,
+	0x22, 0x31, 0x37, 0x35, 0x31, 0x35, 0x34, 0x33, 0x30, 0x30, 0x30, 0x22, 0x52, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x6f, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x50, 0x92, 0x41,
+	0x4d, 0x2a, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x20, 0x61, 0x74, 0x32, 0x31, 0x55,
+	0x6e, 0x69, 0x78, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x62, 0x65, 0x20, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x20, 0x61, 0x6e, 0x79, 0x6d, 0x6f, 0x72, 0x65,
+	0x4a, 0x0c, 0x22, 0x31, 0x37, 0x35, 0x31, 0x36, 0x32, 0x36, 0x34, 0x30, 0x30, 0x22, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x46, 0x0a, 0x02, 0x69, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x33, 0x2a, 0x02, 0x49, 0x50, 0x32, 0x1e,
+	0x49, 0x50, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a, 0x0d,
+	0x22, 0x31, 0x39, 0x32, 0x2e, 0x31, 0x36, 0x38, 0x2e, 0x31, 0x2e, 0x31, 0x22, 0x52, 0x02, 0x69,
+	0x70, 0x12, 0xba, 0x01, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x9a, 0x01, 0x92, 0x41, 0x96, 0x01, 0x2a, 0x0a, 0x55,
+	0x73, 0x65, 0x72, 0x20, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x32, 0x15, 0x52, 0x61, 0x77, 0x20, 0x75,
+	0x73, 0x65, 0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x4a, 0x71, 0x22, 0x4d, 0x6f, 0x7a, 0x69, 0x6c, 0x6c, 0x61, 0x2f, 0x35, 0x2e, 0x30, 0x20, 0x28,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x20, 0x4e, 0x54, 0x20, 0x31, 0x30, 0x2e, 0x30, 0x3b,
+	0x20, 0x57, 0x69, 0x6e, 0x36, 0x34, 0x3b, 0x20, 0x78, 0x36, 0x34, 0x29, 0x20, 0x41, 0x70, 0x70,
+	0x6c, 0x65, 0x57, 0x65, 0x62, 0x4b, 0x69, 0x74, 0x2f, 0x35, 0x33, 0x37, 0x2e, 0x33, 0x36, 0x20,
+	0x28, 0x4b, 0x48, 0x54, 0x4d, 0x4c, 0x2c, 0x20, 0x6c, 0x69, 0x6b, 0x65, 0x20, 0x47, 0x65, 0x63,
+	0x6b, 0x6f, 0x29, 0x20, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x2f, 0x31, 0x32, 0x36, 0x2e, 0x30,
+	0x2e, 0x30, 0x2e, 0x30, 0x20, 0x53, 0x61, 0x66, 0x61, 0x72, 0x69, 0x2f, 0x35, 0x33, 0x37, 0x2e,
+	0x33, 0x36, 0x22, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x54,
+	0x0a, 0x07, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3a, 0x92, 0x41, 0x37, 0x2a, 0x07, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x32, 0x1e, 0x42,
+	0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x20, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x20, 0x66, 0x72,
+	0x6f, 0x6d, 0x20, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22,
+	0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x20, 0x31, 0x32, 0x36, 0x22, 0x52, 0x07, 0x62, 0x72, 0x6f,
+	0x77, 0x73, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x3b, 0x92, 0x41, 0x38, 0x2a, 0x02, 0x4f, 0x53, 0x32, 0x27, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x20, 0x70, 0x61, 0x72, 0x73,
+	0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x4a, 0x09, 0x22, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x52, 0x02, 0x6f,
+	0x73, 0x12, 0x50, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x38, 0x92, 0x41, 0x35, 0x2a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x32, 0x20,
+	0x44, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x2c, 0x20, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x2c,
+	0x20, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x4f, 0x74, 0x68, 0x65, 0x72,
+	0x4a, 0x09, 0x22, 0x44, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x22, 0x52, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x45, 0x92, 0x41, 0x42, 0x2a, 0x07, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x32, 0x31, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20,
+	0x75, 0x73, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x73, 0x0a, 0x08, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x42, 0x42, 0x92, 0x41, 0x3f, 0x2a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x32, 0x33, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6d, 0x6f, 0x73, 0x74, 0x20, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c,
+	0x79, 0x20, 0x75, 0x73, 0x65, 0x64, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x6a, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x55, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0x92, 0x41, 0x33, 0x2a, 0x0a,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x49, 0x44, 0x32, 0x1f, 0x49, 0x64, 0x20, 0x6f,
+	0x66, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x04, 0x22, 0x31, 0x32,
+	0x22, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x91, 0x01, 0x0a,
+	0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x78, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x51,
+	0x92, 0x41, 0x4e, 0x2a, 0x0e, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x20, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x32, 0x36, 0x4b, 0x65, 0x65, 0x70, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74,
+	0x68, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x04, 0x74, 0x72, 0x75,
+	0x65, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x22, 0x60, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x07, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x2b, 0x92, 0x41,
+	0x28, 0x2a, 0x07, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x32, 0x1a, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x20, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x01, 0x33, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x22, 0xca, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x6d, 0x73, 0x67, 0x12, 0x5a, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x44, 0x92, 0x41, 0x41, 0x2a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x32, 0x24,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x2c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20,
+	0x69, 0x66, 0x20, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x4a, 0x12, 0x22, 0x75, 0x73, 0x65, 0x72, 0x40, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x88, 0x01, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x6c, 0x92, 0x41, 0x69, 0x2a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x32, 0x53, 0x33, 0x2d, 0x33, 0x32, 0x20, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x20, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x2c, 0x20, 0x64,
+	0x6f, 0x74, 0x73, 0x2c, 0x20, 0x64, 0x61, 0x73, 0x68, 0x65, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x75,
+	0x6e, 0x64, 0x65, 0x72, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2c, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x69, 0x73,
+	0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x4a, 0x08, 0x22, 0x6b, 0x69, 0x6c, 0x6c, 0x75, 0x61, 0x22,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41,
+	0x34, 0x2a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x32, 0x08, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x4a, 0x1e, 0x22, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x20,
+	0x68, 0x6f, 0x72, 0x73, 0x65, 0x20, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x20, 0x73, 0x74,
+	0x61, 0x70, 0x6c, 0x65, 0x22, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
+	0x70, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x5e, 0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x4a, 0x92, 0x41, 0x47, 0x2a, 0x04, 0x47, 0x55, 0x49, 0x44, 0x32, 0x17, 0x47, 0x55, 0x49, 0x44,
+	0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x75,
+	0x73, 0x65, 0x72, 0x4a, 0x26, 0x22, 0x36, 0x36, 0x64, 0x38, 0x39, 0x62, 0x30, 0x62, 0x2d, 0x65,
+	0x61, 0x61, 0x65, 0x2d, 0x34, 0x38, 0x35, 0x33, 0x2d, 0x39, 0x30, 0x63, 0x33, 0x2d, 0x32, 0x33,
+	0x38, 0x64, 0x34, 0x35, 0x33, 0x31, 0x62, 0x64, 0x31, 0x61, 0x22, 0x52, 0x04, 0x67, 0x75, 0x69,
+	0x64, 0x22, 0x8c, 0x04, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x12,
+	0x56, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x33, 0x2a, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x32, 0x11, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x6f, 0x72, 0x20, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x4a, 0x12, 0x22, 0x75, 0x73, 0x65, 0x72, 0x40, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x34, 0x2a, 0x08,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x32, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x4a, 0x1e, 0x22, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x20, 0x68, 0x6f, 0x72,
+	0x73, 0x65, 0x20, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x20, 0x73, 0x74, 0x61, 0x70, 0x6c,
+	0x65, 0x22, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x6c, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x56, 0x92, 0x41, 0x53,
+	0x2a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x40, 0x53, 0x70, 0x61, 0x63, 0x65, 0x20, 0x73,
+	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x2e,
+	0x20, 0x57, 0x69, 0x74, 0x68, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x20, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x6f, 0x4a, 0x08, 0x22, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x64, 0x22, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6b,
+	0x92, 0x41, 0x68, 0x2a, 0x09, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x49, 0x44, 0x32, 0x50,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20,
+	0x75, 0x73, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x61, 0x75, 0x64, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x20, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x4a, 0x09, 0x22, 0x77, 0x65, 0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0x92, 0x41, 0x40, 0x2a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x32, 0x27, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x20, 0x6f, 0x66,
+	0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x0e, 0x22, 0x6e, 0x2d, 0x30, 0x53,
+	0x36, 0x5f, 0x57, 0x7a, 0x41, 0x32, 0x4d, 0x6a, 0x22, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x22, 0x62, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x46, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0x92, 0x41, 0x2d,
+	0x2a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x32, 0x10, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x6f,
+	0x66, 0x20, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x12, 0x22, 0x75, 0x73, 0x65, 0x72,
+	0x40, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x22, 0xa3, 0x02, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x73,
+	0x67, 0x12, 0x86, 0x01, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x70, 0x92, 0x41, 0x6d, 0x2a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x1e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x20, 0x72, 0x65, 0x73, 0x65, 0x74, 0x20, 0x6c, 0x69, 0x6e, 0x6b, 0x4a, 0x44, 0x22,
+	0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32, 0x6d, 0x58, 0x31, 0x72, 0x32, 0x62,
+	0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x2e, 0x38, 0x63, 0x34, 0x51, 0x62, 0x57, 0x31, 0x6e, 0x33,
+	0x6f, 0x59, 0x74, 0x39, 0x48, 0x6b, 0x32, 0x73, 0x56, 0x64, 0x37, 0x72, 0x45, 0x36, 0x75, 0x4c,
+	0x35, 0x6d, 0x5a, 0x30, 0x61, 0x50, 0x71, 0x34, 0x78, 0x4a, 0x66, 0x47, 0x68, 0x4e, 0x73, 0x31,
+	0x43, 0x77, 0x22, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x7e, 0x0a, 0x0c, 0x6e, 0x65,
+	0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x5b, 0x92, 0x41, 0x58, 0x2a, 0x0c, 0x4e, 0x65, 0x77, 0x20, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x32, 0x28, 0x4e, 0x65, 0x77, 0x20, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x2c, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4a, 0x1e, 0x22,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x20, 0x68, 0x6f, 0x72, 0x73, 0x65, 0x20, 0x62, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x79, 0x20, 0x73, 0x74, 0x61, 0x70, 0x6c, 0x65, 0x22, 0x52, 0x0b, 0x6e,
+	0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x62, 0x0a, 0x19, 0x53, 0x65,
+	0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x45, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x2a, 0x05, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x32, 0x0f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x4a, 0x12, 0x22, 0x75, 0x73, 0x65, 0x72, 0x40, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x98,
+	0x01, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x6d,
+	0x73, 0x67, 0x12, 0x84, 0x01, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x6e, 0x92, 0x41, 0x6b, 0x2a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x1c,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6c, 0x69, 0x6e, 0x6b, 0x4a, 0x44, 0x22, 0x4a,
+	0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32, 0x6d, 0x58, 0x31, 0x72, 0x32, 0x62, 0x4b,
+	0x63, 0x4c, 0x30, 0x70, 0x41, 0x2e, 0x38, 0x63, 0x34, 0x51, 0x62, 0x57, 0x31, 0x6e, 0x33, 0x6f,
+	0x59, 0x74, 0x39, 0x48, 0x6b, 0x32, 0x73, 0x56, 0x64, 0x37, 0x72, 0x45, 0x36, 0x75, 0x4c, 0x35,
+	0x6d, 0x5a, 0x30, 0x61, 0x50, 0x71, 0x34, 0x78, 0x4a, 0x66, 0x47, 0x68, 0x4e, 0x73, 0x31, 0x43,
+	0x77, 0x22, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xdc, 0x02, 0x0a, 0x10, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x7c,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x64,
+	0x92, 0x41, 0x61, 0x2a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x32, 0x33, 0x42, 0x61, 0x73,
+	0x65, 0x33, 0x32, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6d,
+	0x61, 0x6e, 0x75, 0x61, 0x6c, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x69, 0x6e, 0x20, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x20, 0x61, 0x70, 0x70,
+	0x4a, 0x22, 0x22, 0x4a, 0x42, 0x53, 0x57, 0x59, 0x33, 0x44, 0x50, 0x45, 0x48, 0x50, 0x4b, 0x33,
+	0x50, 0x58, 0x50, 0x4a, 0x42, 0x53, 0x57, 0x59, 0x33, 0x44, 0x50, 0x45, 0x48, 0x50, 0x4b, 0x33,
+	0x50, 0x58, 0x50, 0x22, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0xc9, 0x01, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0xb6, 0x01, 0x92, 0x41, 0xb2,
+	0x01, 0x2a, 0x03, 0x55, 0x52, 0x49, 0x32, 0x1e, 0x6f, 0x74, 0x70, 0x61, 0x75, 0x74, 0x68, 0x20,
+	0x55, 0x52, 0x49, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x68, 0x6f, 0x77, 0x20, 0x61, 0x73, 0x20, 0x51,
+	0x52, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x8a, 0x01, 0x22, 0x6f, 0x74, 0x70, 0x61, 0x75, 0x74,
+	0x68, 0x3a, 0x2f, 0x2f, 0x74, 0x6f, 0x74, 0x70, 0x2f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x3a, 0x75, 0x73, 0x65, 0x72, 0x40, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x3f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x3d,
+	0x53, 0x48, 0x41, 0x31, 0x26, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x3d, 0x36, 0x26, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x3d, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x26, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3d, 0x33, 0x30, 0x26, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x3d, 0x4a, 0x42, 0x53, 0x57, 0x59, 0x33, 0x44, 0x50, 0x45, 0x48, 0x50, 0x4b, 0x33, 0x50,
+	0x58, 0x50, 0x4a, 0x42, 0x53, 0x57, 0x59, 0x33, 0x44, 0x50, 0x45, 0x48, 0x50, 0x4b, 0x33, 0x50,
+	0x58, 0x50, 0x22, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x5f, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x4c, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41, 0x35, 0x2a, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x32, 0x23, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x63, 0x6f,
+	0x64, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x20, 0x61, 0x70, 0x70, 0x4a, 0x08, 0x22, 0x31, 0x32, 0x33, 0x34,
+	0x35, 0x36, 0x22, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x11, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0xb4, 0x01, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x8c, 0x01, 0x92, 0x41, 0x88, 0x01, 0x2a,
+	0x0e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x32,
+	0x56, 0x4f, 0x6e, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x20,
+	0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x20, 0x54, 0x4f,
+	0x54, 0x50, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69,
+	0x73, 0x20, 0x6c, 0x6f, 0x73, 0x74, 0x2c, 0x20, 0x73, 0x68, 0x6f, 0x77, 0x6e, 0x20, 0x6f, 0x6e,
+	0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x4a, 0x1e, 0x5b, 0x22, 0x6b, 0x37, 0x6d, 0x32, 0x70,
+	0x2d, 0x78, 0x39, 0x71, 0x72, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x33, 0x76, 0x7a, 0x38, 0x2d,
+	0x6e, 0x77, 0x34, 0x63, 0x65, 0x22, 0x5d, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xbe, 0x04, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x4d, 0x46, 0x41, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x93, 0x01, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x75, 0x92, 0x41,
+	0x72, 0x2a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x32, 0x1f, 0x6d, 0x66,
+	0x61, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4a, 0x44, 0x22,
+	0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32, 0x6d, 0x58, 0x31, 0x72, 0x32, 0x62,
+	0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x2e, 0x38, 0x63, 0x34, 0x51, 0x62, 0x57, 0x31, 0x6e, 0x33,
+	0x6f, 0x59, 0x74, 0x39, 0x48, 0x6b, 0x32, 0x73, 0x56, 0x64, 0x37, 0x72, 0x45, 0x36, 0x75, 0x4c,
+	0x35, 0x6d, 0x5a, 0x30, 0x61, 0x50, 0x71, 0x34, 0x78, 0x4a, 0x66, 0x47, 0x68, 0x4e, 0x73, 0x31,
+	0x43, 0x77, 0x22, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x43,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x92, 0x41,
+	0x2c, 0x2a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x32, 0x1a, 0x54, 0x4f, 0x54, 0x50, 0x20, 0x63, 0x6f,
+	0x64, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x20, 0x63,
+	0x6f, 0x64, 0x65, 0x4a, 0x08, 0x22, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x22, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x6c, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x56, 0x92, 0x41, 0x53, 0x2a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x40,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x20, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x2e, 0x20, 0x57, 0x69, 0x74, 0x68, 0x20, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x6f,
+	0x4a, 0x08, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x22, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x88, 0x01, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6b, 0x92, 0x41, 0x68, 0x2a, 0x09, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x20, 0x49, 0x44, 0x32, 0x50, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68,
+	0x69, 0x63, 0x68, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x49, 0x44, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x69,
+	0x74, 0x73, 0x20, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x20, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x4a, 0x09, 0x22, 0x77, 0x65, 0x62, 0x2d, 0x61, 0x70,
+	0x70, 0x22, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0x92, 0x41, 0x40,
+	0x2a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x32, 0x27, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x63,
+	0x6f, 0x70, 0x69, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x20, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x20, 0x6f, 0x66, 0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4a, 0x0e, 0x22, 0x6e, 0x2d, 0x30, 0x53, 0x36, 0x5f, 0x57, 0x7a, 0x41, 0x32, 0x4d, 0x6a, 0x22,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xfc, 0x02, 0x0a, 0x12, 0x42, 0x65, 0x67, 0x69,
+	0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0xa7,
+	0x01, 0x0a, 0x08, 0x63, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x8a, 0x01, 0x92, 0x41, 0x86, 0x01, 0x2a, 0x08, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f,
+	0x6e, 0x79, 0x32, 0x34, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x75, 0x73, 0x65, 0x20, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79,
+	0x2c, 0x20, 0x73, 0x65, 0x6e, 0x64, 0x20, 0x69, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x20, 0x73, 0x74, 0x65, 0x70, 0x4a, 0x44, 0x22, 0x4a, 0x78, 0x33, 0x76, 0x30,
+	0x61, 0x39, 0x54, 0x51, 0x32, 0x6d, 0x58, 0x31, 0x72, 0x32, 0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70,
+	0x41, 0x2e, 0x38, 0x63, 0x34, 0x51, 0x62, 0x57, 0x31, 0x6e, 0x33, 0x6f, 0x59, 0x74, 0x39, 0x48,
+	0x6b, 0x32, 0x73, 0x56, 0x64, 0x37, 0x72, 0x45, 0x36, 0x75, 0x4c, 0x35, 0x6d, 0x5a, 0x30, 0x61,
+	0x50, 0x71, 0x34, 0x78, 0x4a, 0x66, 0x47, 0x68, 0x4e, 0x73, 0x31, 0x43, 0x77, 0x22, 0x52, 0x08,
+	0x63, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x12, 0xbb, 0x01, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x42, 0x87, 0x01, 0x92, 0x41, 0x83, 0x01, 0x2a, 0x07, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x32, 0x3e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x2c, 0x20, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x20, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34,
+	0x75, 0x72, 0x6c, 0x4a, 0x38, 0x7b, 0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x22, 0x3a, 0x20, 0x7b, 0x22, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x2e, 0x2e, 0x2e, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x70, 0x49, 0x64, 0x22, 0x3a, 0x20,
+	0x22, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x7d, 0x7d, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9c, 0x04, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x9f, 0x01, 0x0a, 0x08, 0x63, 0x65, 0x72,
+	0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x82, 0x01, 0x92, 0x41,
+	0x7f, 0x2a, 0x08, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x32, 0x2d, 0x43, 0x65, 0x72,
+	0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x62,
+	0x79, 0x20, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x44, 0x22, 0x4a, 0x78, 0x33,
+	0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32, 0x6d, 0x58, 0x31, 0x72, 0x32, 0x62, 0x4b, 0x63, 0x4c,
+	0x30, 0x70, 0x41, 0x2e, 0x38, 0x63, 0x34, 0x51, 0x62, 0x57, 0x31, 0x6e, 0x33, 0x6f, 0x59, 0x74,
+	0x39, 0x48, 0x6b, 0x32, 0x73, 0x56, 0x64, 0x37, 0x72, 0x45, 0x36, 0x75, 0x4c, 0x35, 0x6d, 0x5a,
+	0x30, 0x61, 0x50, 0x71, 0x34, 0x78, 0x4a, 0x66, 0x47, 0x68, 0x4e, 0x73, 0x31, 0x43, 0x77, 0x22,
+	0x52, 0x08, 0x63, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x12, 0x8f, 0x02, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0xd5, 0x01, 0x92, 0x41, 0xd1, 0x01, 0x2a,
+	0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x32, 0x4b, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x28, 0x29, 0x20, 0x69, 0x6e, 0x20, 0x4a,
+	0x53, 0x4f, 0x4e, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x4a, 0x76, 0x7b, 0x22, 0x69, 0x64, 0x22, 0x3a,
+	0x20, 0x22, 0x2e, 0x2e, 0x2e, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x61, 0x77, 0x49, 0x64, 0x22, 0x3a,
+	0x20, 0x22, 0x2e, 0x2e, 0x2e, 0x22, 0x2c, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x2d, 0x6b, 0x65, 0x79, 0x22, 0x2c, 0x20, 0x22, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x3a, 0x20, 0x22, 0x2e, 0x2e,
+	0x2e, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x2e, 0x2e, 0x2e, 0x22, 0x7d, 0x7d,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x47, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x2a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x1d, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x70,
+	0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x20, 0x73, 0x68, 0x6f, 0x77, 0x6e, 0x20, 0x74, 0x6f, 0x20,
+	0x75, 0x73, 0x65, 0x72, 0x4a, 0x09, 0x22, 0x4d, 0x61, 0x63, 0x42, 0x6f, 0x6f, 0x6b, 0x22, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50,
+	0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x2a, 0x02, 0x49, 0x44, 0x32, 0x13, 0x49,
+	0x44, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x61, 0x76, 0x65, 0x64, 0x20, 0x70, 0x61, 0x73, 0x73, 0x6b,
+	0x65, 0x79, 0x4a, 0x01, 0x31, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbf, 0x06, 0x0a, 0x16, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x6d, 0x73, 0x67, 0x12, 0x97, 0x01, 0x0a, 0x08, 0x63, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7b, 0x92, 0x41, 0x78, 0x2a, 0x08, 0x43, 0x65,
+	0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x32, 0x26, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79,
+	0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x42, 0x65, 0x67,
+	0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4a, 0x44,
+	0x22, 0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32, 0x6d, 0x58, 0x31, 0x72, 0x32,
+	0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x2e, 0x38, 0x63, 0x34, 0x51, 0x62, 0x57, 0x31, 0x6e,
+	0x33, 0x6f, 0x59, 0x74, 0x39, 0x48, 0x6b, 0x32, 0x73, 0x56, 0x64, 0x37, 0x72, 0x45, 0x36, 0x75,
+	0x4c, 0x35, 0x6d, 0x5a, 0x30, 0x61, 0x50, 0x71, 0x34, 0x78, 0x4a, 0x66, 0x47, 0x68, 0x4e, 0x73,
+	0x31, 0x43, 0x77, 0x22, 0x52, 0x08, 0x63, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x12, 0xb6,
+	0x02, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0xfc, 0x01, 0x92,
+	0x41, 0xf8, 0x01, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x32,
+	0x48, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x62, 0x79,
+	0x20, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x28, 0x29, 0x20, 0x69, 0x6e, 0x20,
+	0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x4a, 0x9f, 0x01, 0x7b, 0x22, 0x69, 0x64,
+	0x22, 0x3a, 0x20, 0x22, 0x2e, 0x2e, 0x2e, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x61, 0x77, 0x49, 0x64,
+	0x22, 0x3a, 0x20, 0x22, 0x2e, 0x2e, 0x2e, 0x22, 0x2c, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x2d, 0x6b, 0x65, 0x79, 0x22, 0x2c, 0x20,
+	0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x3a, 0x20, 0x22,
+	0x2e, 0x2e, 0x2e, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x20, 0x22, 0x2e, 0x2e, 0x2e, 0x22,
+	0x2c, 0x20, 0x22, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x2e, 0x2e, 0x2e, 0x22, 0x2c, 0x20, 0x22, 0x75, 0x73, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x2e, 0x2e, 0x2e, 0x22, 0x7d, 0x7d, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x6c, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x56, 0x92, 0x41, 0x53, 0x2a, 0x05, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x32, 0x40, 0x53, 0x70, 0x61, 0x63, 0x65, 0x20, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x2e, 0x20, 0x57, 0x69, 0x74, 0x68,
+	0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x20, 0x49, 0x44,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64,
+	0x20, 0x74, 0x6f, 0x6f, 0x4a, 0x08, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x22, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6b, 0x92, 0x41, 0x68, 0x2a, 0x09,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x49, 0x44, 0x32, 0x50, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20,
+	0x61, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x20, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x4a, 0x09, 0x22, 0x77, 0x65,
+	0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x59, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x43, 0x92, 0x41, 0x40, 0x2a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x32, 0x27, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x20, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x20, 0x6f, 0x66, 0x20, 0x49, 0x44, 0x20, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x0e, 0x22, 0x6e, 0x2d, 0x30, 0x53, 0x36, 0x5f, 0x57, 0x7a, 0x41,
+	0x32, 0x4d, 0x6a, 0x22, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x64, 0x0a, 0x1a, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x46, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0x92, 0x41, 0x2d, 0x2a, 0x05, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x32, 0x10, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x6f, 0x66, 0x20, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x12, 0x22, 0x75, 0x73, 0x65, 0x72, 0x40, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x22, 0xa5, 0x01, 0x0a, 0x1c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x84, 0x01, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x69, 0x92, 0x41, 0x66, 0x2a, 0x08, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x20, 0x49, 0x44, 0x32, 0x40, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x2c, 0x20, 0x73, 0x65, 0x6e, 0x64, 0x20, 0x69, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+	0x63, 0x6f, 0x64, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x4a, 0x18, 0x22, 0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39,
+	0x54, 0x51, 0x32, 0x6d, 0x58, 0x31, 0x72, 0x32, 0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x22,
+	0x52, 0x07, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xaf, 0x04, 0x0a, 0x1d, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65,
+	0x73, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x77, 0x0a, 0x08, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5c, 0x92,
+	0x41, 0x59, 0x2a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x49, 0x44, 0x32, 0x33, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20,
+	0x62, 0x79, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x61, 0x6b, 0x65,
+	0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x20, 0x6c, 0x69, 0x6e,
+	0x6b, 0x4a, 0x18, 0x22, 0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32, 0x6d, 0x58,
+	0x31, 0x72, 0x32, 0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x22, 0x52, 0x07, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x2a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x32, 0x18, 0x4f,
+	0x6e, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x66, 0x72, 0x6f,
+	0x6d, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4a, 0x08, 0x22, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36,
+	0x22, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x6c, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x56, 0x92, 0x41, 0x53, 0x2a, 0x05, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x32, 0x40, 0x53, 0x70, 0x61, 0x63, 0x65, 0x20, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x2e, 0x20, 0x57, 0x69, 0x74, 0x68,
+	0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x20, 0x49, 0x44,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64,
+	0x20, 0x74, 0x6f, 0x6f, 0x4a, 0x08, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x22, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6b, 0x92, 0x41, 0x68, 0x2a, 0x09,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x49, 0x44, 0x32, 0x50, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20,
+	0x61, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x20, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x4a, 0x09, 0x22, 0x77, 0x65,
+	0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x59, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x43, 0x92, 0x41, 0x40, 0x2a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x32, 0x27, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x20, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x20, 0x6f, 0x66, 0x20, 0x49, 0x44, 0x20, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x0e, 0x22, 0x6e, 0x2d, 0x30, 0x53, 0x36, 0x5f, 0x57, 0x7a, 0x41,
+	0x32, 0x4d, 0x6a, 0x22, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xf3, 0x06, 0x0a, 0x0d,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x4d, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41, 0x25, 0x2a, 0x0d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x20, 0x74, 0x79, 0x70, 0x65, 0x32, 0x0c, 0x4d, 0x75, 0x73, 0x74, 0x20, 0x62,
+	0x65, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x06, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2c, 0x92, 0x41, 0x29, 0x2a, 0x09, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x49, 0x44, 0x32,
+	0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4a, 0x09, 0x22, 0x77, 0x65, 0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x95, 0x01, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x72,
+	0x92, 0x41, 0x6f, 0x2a, 0x0c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x20, 0x55, 0x52,
+	0x49, 0x32, 0x3d, 0x4d, 0x75, 0x73, 0x74, 0x20, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x20, 0x55, 0x52, 0x49, 0x73, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4a, 0x20, 0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68,
+	0x6f, 0x73, 0x74, 0x3a, 0x38, 0x30, 0x38, 0x32, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x22, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x12,
+	0x9b, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x74, 0x92, 0x41, 0x71, 0x2a, 0x0e, 0x43,
+	0x6f, 0x64, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x32, 0x30, 0x42,
+	0x41, 0x53, 0x45, 0x36, 0x34, 0x55, 0x52, 0x4c, 0x28, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x28,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x29, 0x29, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a,
+	0x2d, 0x22, 0x45, 0x39, 0x4d, 0x65, 0x6c, 0x68, 0x6f, 0x61, 0x32, 0x4f, 0x77, 0x76, 0x46, 0x72,
+	0x45, 0x4d, 0x54, 0x4a, 0x67, 0x75, 0x43, 0x48, 0x61, 0x6f, 0x65, 0x4b, 0x31, 0x74, 0x38, 0x55,
+	0x52, 0x57, 0x62, 0x75, 0x47, 0x4a, 0x53, 0x73, 0x74, 0x77, 0x2d, 0x63, 0x4d, 0x22, 0x52, 0x0d,
+	0x63, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x64, 0x0a,
+	0x15, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0x92, 0x41,
+	0x2d, 0x2a, 0x15, 0x43, 0x6f, 0x64, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x20, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x32, 0x0c, 0x4d, 0x75, 0x73, 0x74, 0x20, 0x62,
+	0x65, 0x20, 0x53, 0x32, 0x35, 0x36, 0x4a, 0x06, 0x22, 0x53, 0x32, 0x35, 0x36, 0x22, 0x52, 0x13,
+	0x63, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x63, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x4d, 0x92, 0x41, 0x4a, 0x2a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x32, 0x32,
+	0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4a, 0x0d, 0x22, 0x61, 0x66, 0x30, 0x69, 0x66, 0x6a, 0x73, 0x6c, 0x64, 0x6b, 0x6a,
+	0x22, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x6c, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x56, 0x92, 0x41, 0x53, 0x2a, 0x05, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x32, 0x40, 0x53, 0x70, 0x61, 0x63, 0x65, 0x20, 0x73, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x2e, 0x20, 0x57, 0x69, 0x74,
+	0x68, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x20, 0x49,
+	0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x20, 0x74, 0x6f, 0x6f, 0x4a, 0x08, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x22, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x59, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0x92, 0x41, 0x40, 0x2a, 0x05, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x32, 0x27, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x20,
+	0x74, 0x6f, 0x20, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x20, 0x6f,
+	0x66, 0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x0e, 0x22, 0x6e, 0x2d, 0x30,
+	0x53, 0x36, 0x5f, 0x57, 0x7a, 0x41, 0x32, 0x4d, 0x6a, 0x22, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x22, 0xff, 0x01, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0xe0, 0x01, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0xbe, 0x01, 0x92, 0x41, 0xba, 0x01, 0x2a, 0x0b, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x20, 0x74, 0x6f, 0x32, 0x2f, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x63, 0x6f,
+	0x64, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2c, 0x20, 0x6f, 0x72,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x7a, 0x22, 0x68, 0x74,
+	0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38,
+	0x30, 0x38, 0x32, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x3f, 0x63, 0x6f, 0x64,
+	0x65, 0x3d, 0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32, 0x6d, 0x58, 0x31, 0x72,
+	0x32, 0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x2e, 0x38, 0x63, 0x34, 0x51, 0x62, 0x57, 0x31,
+	0x6e, 0x33, 0x6f, 0x59, 0x74, 0x39, 0x48, 0x6b, 0x32, 0x73, 0x56, 0x64, 0x37, 0x72, 0x45, 0x36,
+	0x75, 0x4c, 0x35, 0x6d, 0x5a, 0x30, 0x61, 0x50, 0x71, 0x34, 0x78, 0x4a, 0x66, 0x47, 0x68, 0x4e,
+	0x73, 0x31, 0x43, 0x77, 0x26, 0x73, 0x74, 0x61, 0x74, 0x65, 0x3d, 0x61, 0x66, 0x30, 0x69, 0x66,
+	0x6a, 0x73, 0x6c, 0x64, 0x6b, 0x6a, 0x22, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x54, 0x6f, 0x22, 0xdc, 0x07, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x73,
+	0x67, 0x12, 0x69, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0x92, 0x41, 0x47, 0x2a, 0x0a, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x32, 0x23, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x14, 0x22, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x85, 0x01, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x71, 0x92, 0x41, 0x6e,
+	0x2a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x32, 0x20, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4a, 0x44, 0x22, 0x4a, 0x78, 0x33, 0x76, 0x30,
+	0x61, 0x39, 0x54, 0x51, 0x32, 0x6d, 0x58, 0x31, 0x72, 0x32, 0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70,
+	0x41, 0x2e, 0x38, 0x63, 0x34, 0x51, 0x62, 0x57, 0x31, 0x6e, 0x33, 0x6f, 0x59, 0x74, 0x39, 0x48,
+	0x6b, 0x32, 0x73, 0x56, 0x64, 0x37, 0x72, 0x45, 0x36, 0x75, 0x4c, 0x35, 0x6d, 0x5a, 0x30, 0x61,
+	0x50, 0x71, 0x34, 0x78, 0x4a, 0x66, 0x47, 0x68, 0x4e, 0x73, 0x31, 0x43, 0x77, 0x22, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x66, 0x92, 0x41, 0x63,
+	0x2a, 0x0c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x20, 0x55, 0x52, 0x49, 0x32, 0x31,
+	0x54, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x75, 0x72, 0x69, 0x20, 0x61, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4a, 0x20, 0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38, 0x30, 0x38, 0x32, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x22, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69,
+	0x12, 0x56, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x39, 0x92, 0x41, 0x36, 0x2a, 0x09, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x20, 0x49, 0x44, 0x32, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68, 0x69, 0x63,
+	0x68, 0x20, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+	0x6f, 0x64, 0x65, 0x4a, 0x09, 0x22, 0x77, 0x65, 0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x94, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x6f, 0x92, 0x41, 0x6c, 0x2a, 0x0d, 0x43, 0x6f, 0x64, 0x65, 0x20, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x32, 0x2c, 0x50, 0x4b, 0x43, 0x45, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6d, 0x61, 0x64, 0x65, 0x20,
+	0x6f, 0x66, 0x4a, 0x2d, 0x22, 0x64, 0x42, 0x6a, 0x66, 0x74, 0x4a, 0x65, 0x5a, 0x34, 0x43, 0x56,
+	0x50, 0x2d, 0x6d, 0x42, 0x39, 0x32, 0x4b, 0x32, 0x37, 0x75, 0x68, 0x62, 0x55, 0x4a, 0x55, 0x31,
+	0x70, 0x31, 0x72, 0x5f, 0x77, 0x57, 0x31, 0x67, 0x46, 0x57, 0x46, 0x4f, 0x45, 0x6a, 0x58, 0x6b,
+	0x22, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0xa4, 0x01, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7f, 0x92, 0x41, 0x7c, 0x2a, 0x0d, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x25, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x4a, 0x44, 0x22, 0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32, 0x6d,
+	0x58, 0x31, 0x72, 0x32, 0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x2e, 0x38, 0x63, 0x34, 0x51,
+	0x62, 0x57, 0x31, 0x6e, 0x33, 0x6f, 0x59, 0x74, 0x39, 0x48, 0x6b, 0x32, 0x73, 0x56, 0x64, 0x37,
+	0x72, 0x45, 0x36, 0x75, 0x4c, 0x35, 0x6d, 0x5a, 0x30, 0x61, 0x50, 0x71, 0x34, 0x78, 0x4a, 0x66,
+	0x47, 0x68, 0x4e, 0x73, 0x31, 0x43, 0x77, 0x22, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0xb9, 0x01, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x93,
+	0x01, 0x92, 0x41, 0x8f, 0x01, 0x2a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x32, 0x4f, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x6f, 0x66, 0x20,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x20, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x42, 0x61, 0x73, 0x69, 0x63,
+	0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x4a, 0x2d, 0x22, 0x71, 0x38, 0x59, 0x62, 0x31, 0x6e, 0x58, 0x32,
+	0x6b, 0x44, 0x34, 0x73, 0x56, 0x36, 0x6d, 0x5a, 0x30, 0x70, 0x4c, 0x33, 0x74, 0x52, 0x37, 0x77,
+	0x45, 0x39, 0x75, 0x4a, 0x35, 0x68, 0x47, 0x66, 0x31, 0x63, 0x41, 0x32, 0x62, 0x4e, 0x34, 0x78,
+	0x4b, 0x38, 0x6f, 0x22, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x22, 0xad, 0x06, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x49, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x2a,
+	0x09, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x49, 0x44, 0x32, 0x11, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4a, 0x09, 0x22,
+	0x77, 0x65, 0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x60, 0x92, 0x41, 0x5d, 0x2a, 0x0c,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x32, 0x47, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x83, 0x01, 0x0a, 0x0b, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x62, 0x92, 0x41, 0x5f, 0x2a, 0x0b, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x32, 0x27, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x27, 0x5b, 0x22, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x5d, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x9c, 0x01, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x77, 0x92, 0x41, 0x74, 0x2a, 0x0d, 0x52, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x20, 0x55, 0x52, 0x49, 0x73, 0x32, 0x3f, 0x41, 0x62, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x65, 0x20, 0x55, 0x52, 0x49, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x20, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x22, 0x5b, 0x22,
+	0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74,
+	0x3a, 0x38, 0x30, 0x38, 0x32, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x5d,
+	0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x73, 0x12, 0x4a,
+	0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x32,
+	0x92, 0x41, 0x2f, 0x2a, 0x06, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x32, 0x19, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x6d, 0x61, 0x79, 0x20, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x0a, 0x5b, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64,
+	0x22, 0x5d, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x69, 0x0a, 0x10, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x3f, 0x92, 0x41, 0x3c, 0x2a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x20, 0x6c, 0x69, 0x66, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x32, 0x21, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x2c, 0x20, 0x30, 0x20, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x20, 0x41, 0x43,
+	0x43, 0x45, 0x53, 0x53, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4a, 0x05,
+	0x22, 0x36, 0x30, 0x30, 0x22, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x66,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x6b, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x3f, 0x92, 0x41, 0x3c, 0x2a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x6c,
+	0x69, 0x66, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x32, 0x22, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x2c, 0x20, 0x30, 0x20, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x20, 0x52, 0x45, 0x46, 0x52, 0x45,
+	0x53, 0x48, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4a, 0x03, 0x22, 0x30,
+	0x22, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4c, 0x69, 0x66, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xa6, 0x05, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x49, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x2a,
+	0x09, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x49, 0x44, 0x32, 0x11, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4a, 0x09, 0x22,
+	0x77, 0x65, 0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x83, 0x01, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x62, 0x92, 0x41, 0x5f, 0x2a, 0x0b, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x32, 0x27, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x61,
+	0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4a, 0x27, 0x5b, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5d, 0x52, 0x0a, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x0d, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x77, 0x92, 0x41, 0x74, 0x2a, 0x0d, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x20,
+	0x55, 0x52, 0x49, 0x73, 0x32, 0x3f, 0x41, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x20, 0x55,
+	0x52, 0x49, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x66, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x22, 0x5b, 0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38, 0x30, 0x38, 0x32, 0x2f, 0x63,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x5d, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x73, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x2a, 0x06, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x32, 0x19, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x20, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x20, 0x6d, 0x61, 0x79, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a,
+	0x0a, 0x5b, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x22, 0x5d, 0x52, 0x06, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x12, 0x69, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69,
+	0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3f, 0x92,
+	0x41, 0x3c, 0x2a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x6c, 0x69, 0x66, 0x65, 0x20,
+	0x74, 0x69, 0x6d, 0x65, 0x32, 0x21, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x2c, 0x20, 0x30,
+	0x20, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x20, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x4c, 0x49,
+	0x46, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4a, 0x05, 0x22, 0x36, 0x30, 0x30, 0x22, 0x52, 0x0e,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x66, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x6b,
+	0x0a, 0x11, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3f, 0x92, 0x41, 0x3c, 0x2a, 0x11,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x6c, 0x69, 0x66, 0x65, 0x20, 0x74, 0x69, 0x6d,
+	0x65, 0x32, 0x22, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x2c, 0x20, 0x30, 0x20, 0x6d, 0x65,
+	0x61, 0x6e, 0x73, 0x20, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x4c, 0x49, 0x46, 0x45,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4a, 0x03, 0x22, 0x30, 0x22, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x4c, 0x69, 0x66, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x16, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x49, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x2a, 0x09, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x49, 0x44, 0x32, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4a, 0x09, 0x22, 0x77, 0x65,
+	0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x5e, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x6d, 0x73, 0x67, 0x12, 0x49, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x2a, 0x09, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x49, 0x44, 0x32, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4a, 0x09, 0x22, 0x77, 0x65,
+	0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0xca, 0x08, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x49, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x2a, 0x09, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x20, 0x49, 0x44, 0x32, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4a, 0x09, 0x22, 0x77, 0x65, 0x62, 0x2d, 0x61, 0x70,
+	0x70, 0x22, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0xa6, 0x01, 0x0a,
+	0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x80, 0x01, 0x92, 0x41, 0x7d, 0x2a, 0x0d, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x32, 0x3d, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x20, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4a, 0x2d, 0x22, 0x71, 0x38, 0x59, 0x62, 0x31,
+	0x6e, 0x58, 0x32, 0x6b, 0x44, 0x34, 0x73, 0x56, 0x36, 0x6d, 0x5a, 0x30, 0x70, 0x4c, 0x33, 0x74,
+	0x52, 0x37, 0x77, 0x45, 0x39, 0x75, 0x4a, 0x35, 0x68, 0x47, 0x66, 0x31, 0x63, 0x41, 0x32, 0x62,
+	0x4e, 0x34, 0x78, 0x4b, 0x38, 0x6f, 0x22, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x4e, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2a, 0x92, 0x41, 0x27,
+	0x2a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x32, 0x11,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x68, 0x61, 0x73, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x83, 0x01, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x62, 0x92, 0x41, 0x5f,
+	0x2a, 0x0b, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x32, 0x27, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x20, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x27, 0x5b, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x20, 0x22,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5d, 0x52,
+	0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x0d,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x77, 0x92, 0x41, 0x74, 0x2a, 0x0d, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x20, 0x55, 0x52, 0x49, 0x73, 0x32, 0x3f, 0x41, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x65, 0x20, 0x55, 0x52, 0x49, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x66,
+	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x4a, 0x22, 0x5b, 0x22, 0x68, 0x74, 0x74, 0x70,
+	0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38, 0x30, 0x38,
+	0x32, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x5d, 0x52, 0x0c, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x73, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x2a,
+	0x06, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x32, 0x19, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x20,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x6d, 0x61, 0x79, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4a, 0x0a, 0x5b, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x22, 0x5d, 0x52, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x69, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x3f, 0x92, 0x41, 0x3c, 0x2a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x6c, 0x69,
+	0x66, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x32, 0x21, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x2c, 0x20, 0x30, 0x20, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x20, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x5f, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4a, 0x05, 0x22, 0x36, 0x30, 0x30,
+	0x22, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x66, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x6b, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x6c, 0x69, 0x66,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3f, 0x92, 0x41,
+	0x3c, 0x2a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x6c, 0x69, 0x66, 0x65, 0x20,
+	0x74, 0x69, 0x6d, 0x65, 0x32, 0x22, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x2c, 0x20, 0x30,
+	0x20, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x20, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x4c,
+	0x49, 0x46, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4a, 0x03, 0x22, 0x30, 0x22, 0x52, 0x0f, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4c, 0x69, 0x66, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x54,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x38, 0x92, 0x41, 0x35, 0x2a, 0x08, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x32,
+	0x22, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x20, 0x63, 0x61, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x67, 0x65, 0x74, 0x20, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x4a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x38, 0x92, 0x41, 0x35, 0x2a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x61, 0x74, 0x32, 0x19, 0x55, 0x6e, 0x69, 0x78, 0x20,
+	0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x0c, 0x22, 0x31, 0x37, 0x35, 0x31, 0x35, 0x34, 0x33, 0x37, 0x34,
+	0x33, 0x22, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xde, 0xcf,
+	0x01, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0xf4, 0x06, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0xb8, 0x06, 0x92, 0x41, 0x9a, 0x06, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x0a, 0x47, 0x65, 0x74, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a, 0x93, 0x01, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x65, 0x74, 0x20, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x69, 0x72, 0x20, 0x62, 0x79, 0x20, 0x62, 0x61,
+	0x72, 0x65, 0x20, 0x47, 0x55, 0x49, 0x44, 0x2e, 0x20, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x20, 0x72, 0x75, 0x6e, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x20, 0x28, 0x54, 0x52, 0x55, 0x53, 0x54,
+	0x45, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x3d, 0x74, 0x72, 0x75, 0x65, 0x29, 0x2c, 0x20, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x77, 0x69, 0x73, 0x65, 0x20, 0x75, 0x73, 0x65, 0x20, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x4a, 0x5f, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x58, 0x22, 0x56, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x42, 0x7b, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x4a, 0x57, 0x54,
+	0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x20,
+	0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x3a, 0x20, 0x22, 0x62, 0x61, 0x73, 0x65,
+	0x20, 0x36, 0x34, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x7d, 0x4a, 0x97, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x8f, 0x01, 0x0a, 0x44,
+	0x49, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x20, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x20, 0x55, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x20, 0x6f, 0x72, 0x20, 0x58, 0x2d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64,
+	0x2d, 0x46, 0x6f, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x64, 0x22, 0x47, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x33, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x3a, 0x20, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x20, 0x69,
+	0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x64, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x7d, 0x4a, 0x84, 0x01,
+	0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x7d, 0x0a, 0x1e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x47, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x62, 0x79,
+	0x20, 0x67, 0x75, 0x69, 0x64, 0x20, 0x69, 0x73, 0x20, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x2c, 0x20, 0x75, 0x73, 0x65, 0x20, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x22, 0x7d, 0x4a, 0x48, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x41, 0x0a, 0x0e, 0x47,
+	0x55, 0x49, 0x44, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x2f, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0x1b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x55,
+	0x49, 0x44, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x7d, 0x4a, 0xa3,
+	0x01, 0x0a, 0x03, 0x34, 0x32, 0x39, 0x12, 0x9b, 0x01, 0x0a, 0x45, 0x55, 0x73, 0x65, 0x72, 0x20,
+	0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x20, 0x4d, 0x41, 0x58, 0x5f, 0x53, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x22, 0x52, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x3e, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x72,
+	0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x2c, 0x20, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x20, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x61, 0x6e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0xf0,
+	0x06, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa8, 0x06, 0x92, 0x41, 0x86, 0x06, 0x0a, 0x04, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x20, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x88, 0x01, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x69, 0x72, 0x2e, 0x20, 0x46, 0x75,
+	0x6e, 0x63, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x20, 0x69, 0x66, 0x20, 0x69, 0x70, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x2e, 0x20, 0x49, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x66, 0x75, 0x6e, 0x63,
+	0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x6c, 0x64, 0x20, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x73, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2e, 0x4a, 0x6d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x66, 0x22, 0x64, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x50, 0x7b, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x6e, 0x65, 0x77,
+	0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x20,
+	0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x3a, 0x20, 0x22, 0x6e, 0x65, 0x77, 0x20,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x7d, 0x4a, 0x82, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x7b, 0x0a, 0x33, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x20, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x78, 0x2d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x2d,
+	0x66, 0x6f, 0x72, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64,
+	0x22, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x78, 0x2d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2d, 0x66, 0x6f, 0x72,
+	0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x81, 0x02, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0xf9,
+	0x01, 0x0a, 0xc6, 0x01, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x64, 0x2e, 0x20, 0x4f, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x20, 0x68, 0x61, 0x73, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x2e, 0x20,
+	0x4f, 0x72, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x20, 0x69, 0x74, 0x73, 0x20, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x20,
+	0x6f, 0x72, 0x20, 0x69, 0x64, 0x6c, 0x65, 0x20, 0x6c, 0x69, 0x66, 0x65, 0x20, 0x74, 0x69, 0x6d,
+	0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x68, 0x61,
+	0x73, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x20, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x20, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x68, 0x61, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x22, 0x2e, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1a,
+	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x59, 0x0a, 0x03, 0x35, 0x30,
+	0x30, 0x12, 0x52, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x3a, 0x20, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x7d, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65,
+	0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x12, 0x9a, 0x04, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x55, 0x49, 0x44, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x55, 0x49, 0x44, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xe0, 0x03, 0x92, 0x41,
+	0xc4, 0x03, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x10, 0x47, 0x65, 0x74, 0x20, 0x47, 0x55,
+	0x49, 0x44, 0x20, 0x6f, 0x66, 0x20, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x2f, 0x54, 0x68, 0x69, 0x73,
+	0x20, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+	0x75, 0x73, 0x65, 0x72, 0x20, 0x67, 0x75, 0x69, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x4d, 0x0a, 0x03, 0x32,
+	0x30, 0x30, 0x12, 0x46, 0x22, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x7b, 0x22, 0x67, 0x75, 0x69, 0x64,
+	0x22, 0x3a, 0x20, 0x22, 0x36, 0x36, 0x64, 0x38, 0x39, 0x62, 0x30, 0x62, 0x2d, 0x65, 0x61, 0x61,
+	0x65, 0x2d, 0x34, 0x38, 0x35, 0x33, 0x2d, 0x39, 0x30, 0x63, 0x33, 0x2d, 0x32, 0x33, 0x38, 0x64,
+	0x34, 0x35, 0x33, 0x31, 0x62, 0x64, 0x31, 0x61, 0x22, 0x7d, 0x4a, 0x68, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x61, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22, 0x45, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0x31, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x64, 0x22, 0x7d, 0x4a, 0x60, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x59, 0x0a, 0x20, 0x41,
+	0x75, 0x74, 0x68, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2c, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x69, 0x73, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22,
+	0x35, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x21, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x50, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x49, 0x0a,
+	0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x37, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x23, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
+	0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x7d, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22,
+	0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x67, 0x75, 0x69, 0x64, 0x12, 0xa6,
+	0x03, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xeb, 0x02, 0x92, 0x41, 0xd1, 0x02,
+	0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x20, 0x6c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x1a, 0x3b, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72,
+	0x2c, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4a, 0x1f, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x18, 0x22, 0x16, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x02, 0x7b,
+	0x7d, 0x4a, 0x65, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x5e, 0x0a, 0x2c, 0x41, 0x75, 0x74, 0x68,
+	0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2c, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x20, 0x6f, 0x72,
+	0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x7b, 0x22,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x69, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12,
+	0x62, 0x0a, 0x25, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x20, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x20, 0x64, 0x62, 0x73, 0x22, 0x39, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x7b, 0x22,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20,
+	0x74, 0x6f, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x7d, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x91, 0x03, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0xd7, 0x02, 0x92, 0x41, 0xbb, 0x02, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x08,
+	0x41, 0x64, 0x64, 0x20, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x75, 0x41, 0x64, 0x64, 0x73, 0x20, 0x75,
+	0x73, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x44, 0x42, 0x2c, 0x20, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x67, 0x75, 0x69, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x6e,
+	0x65, 0x77, 0x20, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x20, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x2c, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x77, 0x69,
+	0x73, 0x65, 0x20, 0x75, 0x73, 0x65, 0x20, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4a,
+	0x4d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x46, 0x22, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x7b, 0x22,
+	0x67, 0x75, 0x69, 0x64, 0x22, 0x3a, 0x20, 0x22, 0x36, 0x36, 0x64, 0x38, 0x39, 0x62, 0x30, 0x62,
+	0x2d, 0x65, 0x61, 0x61, 0x65, 0x2d, 0x34, 0x38, 0x35, 0x33, 0x2d, 0x39, 0x30, 0x63, 0x33, 0x2d,
+	0x32, 0x33, 0x38, 0x64, 0x34, 0x35, 0x33, 0x31, 0x62, 0x64, 0x31, 0x61, 0x22, 0x7d, 0x4a, 0x63,
+	0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x5c, 0x0a, 0x25, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x64, 0x62, 0x73, 0x22, 0x33,
+	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x12, 0x1f, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x64, 0x64, 0x20, 0x75, 0x73, 0x65,
+	0x72, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x64, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x12, 0xd2, 0x04, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x4a, 0x57, 0x4b, 0x53, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x98, 0x04, 0x92, 0x41, 0xf6, 0x03, 0x0a, 0x04, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x14, 0x47, 0x65, 0x74, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x57, 0x65, 0x62,
+	0x20, 0x4b, 0x65, 0x79, 0x20, 0x53, 0x65, 0x74, 0x1a, 0xae, 0x01, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20, 0x6b, 0x65, 0x79, 0x73, 0x20, 0x77,
+	0x68, 0x69, 0x63, 0x68, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x20, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x3a, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x20, 0x6b, 0x65, 0x79, 0x73, 0x20,
+	0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x74, 0x69, 0x6c, 0x6c, 0x20, 0x69,
+	0x6e, 0x20, 0x67, 0x72, 0x61, 0x63, 0x65, 0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2e, 0x20,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20,
+	0x66, 0x72, 0x6f, 0x6d, 0x20, 0x43, 0x61, 0x63, 0x68, 0x65, 0x2d, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4a, 0xd4, 0x01, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0xcc, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xb4, 0x01, 0x7b, 0x22, 0x6b, 0x65,
+	0x79, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x7b, 0x22, 0x6b, 0x74, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x4f,
+	0x4b, 0x50, 0x22, 0x2c, 0x20, 0x22, 0x75, 0x73, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x69, 0x67,
+	0x22, 0x2c, 0x20, 0x22, 0x6b, 0x69, 0x64, 0x22, 0x3a, 0x20, 0x22, 0x6e, 0x6d, 0x32, 0x79, 0x65,
+	0x53, 0x63, 0x50, 0x56, 0x43, 0x4f, 0x69, 0x62, 0x71, 0x52, 0x35, 0x6b, 0x38, 0x6d, 0x6e, 0x6e,
+	0x4b, 0x72, 0x32, 0x48, 0x58, 0x6f, 0x70, 0x31, 0x55, 0x49, 0x34, 0x32, 0x78, 0x43, 0x49, 0x4f,
+	0x7a, 0x68, 0x77, 0x43, 0x44, 0x4d, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x6c, 0x67, 0x22, 0x3a, 0x20,
+	0x22, 0x45, 0x64, 0x44, 0x53, 0x41, 0x22, 0x2c, 0x20, 0x22, 0x63, 0x72, 0x76, 0x22, 0x3a, 0x20,
+	0x22, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x22, 0x2c, 0x20, 0x22, 0x78, 0x22, 0x3a, 0x20,
+	0x22, 0x31, 0x31, 0x71, 0x59, 0x41, 0x59, 0x4b, 0x78, 0x43, 0x72, 0x66, 0x56, 0x53, 0x5f, 0x37,
+	0x54, 0x79, 0x57, 0x51, 0x48, 0x4f, 0x67, 0x37, 0x68, 0x63, 0x76, 0x50, 0x61, 0x70, 0x69, 0x4d,
+	0x6c, 0x72, 0x77, 0x49, 0x61, 0x61, 0x50, 0x63, 0x48, 0x55, 0x52, 0x6f, 0x22, 0x7d, 0x5d, 0x7d,
+	0x4a, 0x50, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x49, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x7b,
+	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x20, 0x74, 0x6f, 0x20, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x20, 0x6a, 0x77, 0x6b, 0x73,
+	0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x2e, 0x77, 0x65, 0x6c, 0x6c,
+	0x2d, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x2f, 0x6a, 0x77, 0x6b, 0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0xbc, 0x05, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0xf3, 0x04, 0x92, 0x41, 0xc6, 0x04,
+	0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x18, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44, 0x20, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x20, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x1a, 0x70, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x44,
+	0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x20,
+	0x4f, 0x49, 0x44, 0x43, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x66, 0x69, 0x6e, 0x64, 0x20, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x2c, 0x20, 0x6a, 0x77, 0x6b, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x4a, 0xcf, 0x02, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xc7, 0x02, 0x22, 0xc4, 0x02,
+	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x12, 0xaf, 0x02, 0x7b, 0x22, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73,
+	0x74, 0x3a, 0x38, 0x38, 0x38, 0x30, 0x22, 0x2c, 0x20, 0x22, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x75,
+	0x72, 0x69, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38, 0x38, 0x38, 0x30, 0x2f, 0x2e, 0x77, 0x65, 0x6c,
+	0x6c, 0x2d, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x2f, 0x6a, 0x77, 0x6b, 0x73, 0x2e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x3a, 0x20, 0x5b,
+	0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x22, 0x5d, 0x2c, 0x20, 0x22, 0x69, 0x64, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x22, 0x3a, 0x20, 0x5b, 0x22, 0x52, 0x53, 0x32, 0x35, 0x36, 0x22, 0x5d, 0x2c, 0x20, 0x22,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x22, 0x3a, 0x20, 0x5b, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x22, 0x5d, 0x2c, 0x20, 0x22,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x22, 0x3a, 0x20, 0x5b, 0x22, 0x69, 0x73, 0x73, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x75, 0x62, 0x22,
+	0x2c, 0x20, 0x22, 0x61, 0x75, 0x64, 0x22, 0x2c, 0x20, 0x22, 0x65, 0x78, 0x70, 0x22, 0x2c, 0x20,
+	0x22, 0x69, 0x61, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x2c, 0x20, 0x22, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x69,
+	0x64, 0x22, 0x5d, 0x7d, 0x4a, 0x60, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x59, 0x0a, 0x0e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x47, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0x33, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x20,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x2e,
+	0x77, 0x65, 0x6c, 0x6c, 0x2d, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x64, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0xec, 0x06, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x72,
+	0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x1a,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0xa6, 0x06, 0x92, 0x41, 0x86, 0x06, 0x0a, 0x04, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x13, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xe6, 0x02, 0x52, 0x46, 0x43, 0x20, 0x37, 0x36,
+	0x36, 0x32, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x20, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x6d,
+	0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x20, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2c, 0x20, 0x69, 0x74, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x42, 0x61, 0x73, 0x69, 0x63, 0x20, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x2e, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x77, 0x77, 0x77, 0x2d, 0x66, 0x6f, 0x72,
+	0x6d, 0x2d, 0x75, 0x72, 0x6c, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20,
+	0x6a, 0x73, 0x6f, 0x6e, 0x20, 0x62, 0x6f, 0x64, 0x79, 0x2e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x69, 0x73, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20,
+	0x69, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x69, 0x74, 0x73, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74, 0x69,
+	0x6c, 0x6c, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x2c, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x20, 0x69, 0x73, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x32, 0x21, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d,
+	0x77, 0x77, 0x77, 0x2d, 0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x75, 0x72, 0x6c, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x2e, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x27, 0x22, 0x25,
+	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x12, 0x11, 0x7b, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x3a, 0x20, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x7d, 0x4a, 0xc8, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0xc0, 0x01,
+	0x0a, 0x58, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x20, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x2c, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x75,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x74, 0x73, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x20, 0x69, 0x73, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x22, 0x64, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x50,
+	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a,
+	0x20, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x7d,
+	0x4a, 0x50, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x49, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x7b,
+	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x6f, 0x61,
+	0x75, 0x74, 0x68, 0x2f, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x82,
+	0x0a, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0xc4, 0x09, 0x92,
+	0x41, 0xa8, 0x09, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x10, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xe9, 0x04, 0x52, 0x46,
+	0x43, 0x20, 0x37, 0x30, 0x30, 0x39, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x72, 0x65, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x20, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20, 0x6c, 0x69,
+	0x6b, 0x65, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x3a, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x42,
+	0x61, 0x73, 0x69, 0x63, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2c, 0x20, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62,
+	0x65, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x2e, 0x20, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x2d, 0x70, 0x61, 0x72, 0x74, 0x79, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x68, 0x61,
+	0x76, 0x65, 0x20, 0x6e, 0x6f, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x73, 0x6f,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x20, 0x75, 0x73, 0x65, 0x72,
+	0x20, 0x73, 0x65, 0x6e, 0x64, 0x73, 0x20, 0x6f, 0x77, 0x6e, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x6e, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65,
+	0x72, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20,
+	0x6f, 0x77, 0x6e, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x2d, 0x70, 0x61, 0x72, 0x74, 0x79, 0x20,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x20, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x64, 0x20, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x20, 0x69, 0x74, 0x73, 0x20,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x69, 0x74, 0x73,
+	0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x67, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x69, 0x74, 0x73, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2e, 0x20, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65,
+	0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x32, 0x30, 0x30, 0x20, 0x61, 0x73, 0x20, 0x77, 0x65,
+	0x6c, 0x6c, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x4f, 0x41, 0x75, 0x74, 0x68,
+	0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x32, 0x21, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x77, 0x77, 0x77, 0x2d, 0x66, 0x6f, 0x72, 0x6d, 0x2d,
+	0x75, 0x72, 0x6c, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x1f, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x18, 0x22, 0x16, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x02, 0x7b, 0x7d, 0x4a, 0xb8, 0x01,
+	0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0xb0, 0x01, 0x0a, 0x3d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20,
+	0x77, 0x61, 0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x6e,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x72, 0x20,
+	0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x6e, 0x6f, 0x74, 0x68,
+	0x65, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72, 0x22, 0x6f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x5b, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x77, 0x61, 0x73, 0x20, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x6e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x7d, 0x4a, 0xa5, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x31,
+	0x12, 0x9d, 0x01, 0x0a, 0x35, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x75,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x74, 0x73, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x20, 0x69, 0x73, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x22, 0x64, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x50,
+	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a,
+	0x20, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x7d,
+	0x4a, 0x69, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x62, 0x0a, 0x25, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x20,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x64, 0x62, 0x73,
+	0x22, 0x39, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x12, 0xbe, 0x06, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xfa, 0x05, 0x92, 0x41, 0xe1, 0x05, 0x0a, 0x04,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x71, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x20, 0x28, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x29, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x20, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x20, 0x69, 0x74, 0x73, 0x65, 0x6c, 0x66, 0x20, 0x69, 0x73, 0x20, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4a, 0xf3, 0x02,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xeb, 0x02, 0x22, 0xe8, 0x02, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xd3, 0x02,
+	0x7b, 0x22, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x7b, 0x22,
+	0x69, 0x64, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x32, 0x22, 0x2c, 0x20, 0x22, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x37, 0x35, 0x31, 0x35, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0x22, 0x2c, 0x20, 0x22, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x37, 0x35, 0x31, 0x35, 0x34, 0x33, 0x30, 0x30, 0x30, 0x22,
+	0x2c, 0x20, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x3a, 0x20, 0x22,
+	0x31, 0x37, 0x35, 0x31, 0x36, 0x32, 0x36, 0x34, 0x30, 0x30, 0x22, 0x2c, 0x20, 0x22, 0x69, 0x70,
+	0x22, 0x3a, 0x20, 0x22, 0x31, 0x39, 0x32, 0x2e, 0x31, 0x36, 0x38, 0x2e, 0x31, 0x2e, 0x31, 0x22,
+	0x2c, 0x20, 0x22, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x22, 0x3a, 0x20, 0x22,
+	0x4d, 0x6f, 0x7a, 0x69, 0x6c, 0x6c, 0x61, 0x2f, 0x35, 0x2e, 0x30, 0x20, 0x28, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x20, 0x4e, 0x54, 0x20, 0x31, 0x30, 0x2e, 0x30, 0x3b, 0x20, 0x57, 0x69,
+	0x6e, 0x36, 0x34, 0x3b, 0x20, 0x78, 0x36, 0x34, 0x29, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x57,
+	0x65, 0x62, 0x4b, 0x69, 0x74, 0x2f, 0x35, 0x33, 0x37, 0x2e, 0x33, 0x36, 0x20, 0x28, 0x4b, 0x48,
+	0x54, 0x4d, 0x4c, 0x2c, 0x20, 0x6c, 0x69, 0x6b, 0x65, 0x20, 0x47, 0x65, 0x63, 0x6b, 0x6f, 0x29,
+	0x20, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x2f, 0x31, 0x32, 0x36, 0x2e, 0x30, 0x2e, 0x30, 0x2e,
+	0x30, 0x20, 0x53, 0x61, 0x66, 0x61, 0x72, 0x69, 0x2f, 0x35, 0x33, 0x37, 0x2e, 0x33, 0x36, 0x22,
+	0x2c, 0x20, 0x22, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68,
+	0x72, 0x6f, 0x6d, 0x65, 0x20, 0x31, 0x32, 0x36, 0x22, 0x2c, 0x20, 0x22, 0x6f, 0x73, 0x22, 0x3a,
+	0x20, 0x22, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x2c, 0x20, 0x22, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x22, 0x2c,
+	0x20, 0x22, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x3a, 0x20, 0x74, 0x72, 0x75, 0x65,
+	0x7d, 0x5d, 0x7d, 0x4a, 0x79, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x72, 0x0a, 0x39, 0x41, 0x75,
+	0x74, 0x68, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2c, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20,
+	0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x2c,
+	0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x35, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73,
+	0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x51,
+	0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x4a, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x24, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x7d, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0xef, 0x04, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x67,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xab, 0x04, 0x92, 0x41, 0x85, 0x04, 0x0a,
+	0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x20, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x6f,
+	0x6e, 0x65, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x20, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x20, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79,
+	0x4a, 0x1f, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x18, 0x22, 0x16, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x02, 0x7b,
+	0x7d, 0x4a, 0x79, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x72, 0x0a, 0x39, 0x41, 0x75, 0x74, 0x68,
+	0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2c, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x2c, 0x20, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x35, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x7b, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x62,
+	0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x5d, 0x0a, 0x03,
+	0x34, 0x30, 0x34, 0x12, 0x56, 0x0a, 0x20, 0x55, 0x73, 0x65, 0x72, 0x20, 0x68, 0x61, 0x73, 0x20,
+	0x6e, 0x6f, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+	0x73, 0x75, 0x63, 0x68, 0x20, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20,
+	0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x7d, 0x4a, 0x69, 0x0a, 0x03, 0x35,
+	0x30, 0x30, 0x12, 0x62, 0x0a, 0x25, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x20, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x64, 0x62, 0x73, 0x22, 0x39, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x25, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x20, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xcd, 0x04, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xf9, 0x03, 0x92, 0x41, 0xe0,
+	0x03, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x20,
+	0x61, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xa1, 0x01, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20,
+	0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x20, 0x74, 0x6f, 0x67, 0x65, 0x74, 0x68, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+	0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x2e, 0x20, 0x57, 0x69, 0x74, 0x68, 0x20, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x74, 0x72, 0x75, 0x65, 0x20, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x69, 0x73,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6b, 0x65, 0x70, 0x74,
+	0x4a, 0x2b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x24, 0x22, 0x22, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x7b,
+	0x22, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x3a, 0x20, 0x33, 0x7d, 0x4a, 0x79, 0x0a,
+	0x03, 0x34, 0x30, 0x31, 0x12, 0x72, 0x0a, 0x39, 0x41, 0x75, 0x74, 0x68, 0x20, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x2c, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x2c, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x22, 0x35, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a,
+	0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x69, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12,
+	0x62, 0x0a, 0x25, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x20, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x20, 0x64, 0x62, 0x73, 0x22, 0x39, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x7b, 0x22,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20,
+	0x74, 0x6f, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x7d, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x2a, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xe1, 0x08, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0xa8, 0x08, 0x92, 0x41, 0x8c, 0x08, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0d, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72, 0x1a, 0xa1, 0x01, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x61, 0x6e, 0x64, 0x2f, 0x6f, 0x72, 0x20, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x2e, 0x20, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x20,
+	0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x20, 0x28,
+	0x74, 0x72, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x63, 0x61, 0x73, 0x65, 0x64, 0x29, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x75, 0x73, 0x74,
+	0x20, 0x62, 0x65, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x2e, 0x20, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x20, 0x69, 0x73, 0x20, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x20, 0x61,
+	0x73, 0x20, 0x41, 0x72, 0x67, 0x6f, 0x6e, 0x32, 0x69, 0x64, 0x20, 0x68, 0x61, 0x73, 0x68, 0x4a,
+	0x4d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x46, 0x22, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x7b, 0x22,
+	0x67, 0x75, 0x69, 0x64, 0x22, 0x3a, 0x20, 0x22, 0x36, 0x36, 0x64, 0x38, 0x39, 0x62, 0x30, 0x62,
+	0x2d, 0x65, 0x61, 0x61, 0x65, 0x2d, 0x34, 0x38, 0x35, 0x33, 0x2d, 0x39, 0x30, 0x63, 0x33, 0x2d,
+	0x32, 0x33, 0x38, 0x64, 0x34, 0x35, 0x33, 0x31, 0x62, 0x64, 0x31, 0x61, 0x22, 0x7d, 0x4a, 0xb0,
+	0x04, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0xa8, 0x04, 0x0a, 0x89, 0x01, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x6f, 0x72, 0x20, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2e, 0x20, 0x4f, 0x72, 0x20, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x20, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x20, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x20, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x99, 0x03, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x84, 0x03, 0x7b, 0x22, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x33, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x64, 0x6f,
+	0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x79, 0x20, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x2c, 0x20, 0x22, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x22, 0x3a, 0x20, 0x5b, 0x7b, 0x22, 0x40, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x7b,
+	0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x2c, 0x20, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x6d, 0x75,
+	0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x74, 0x20, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x20, 0x38,
+	0x20, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x22, 0x2c, 0x20, 0x22, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52,
+	0x44, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x22, 0x7d, 0x2c, 0x20, 0x7b,
+	0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x2c, 0x20, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x3a, 0x20, 0x22, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x77, 0x61,
+	0x73, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20,
+	0x62, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x73, 0x2c, 0x20, 0x63, 0x68, 0x6f, 0x6f, 0x73, 0x65,
+	0x20, 0x61, 0x6e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x20, 0x22,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f,
+	0x52, 0x44, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x43, 0x48, 0x45, 0x44, 0x22, 0x7d, 0x5d, 0x7d, 0x5d,
+	0x7d, 0x4a, 0x75, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12, 0x6e, 0x0a, 0x1a, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x20, 0x6f, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x69, 0x73,
+	0x20, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x22, 0x50, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x3c, 0x7b, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x73, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x73, 0x75, 0x63, 0x68, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x6f, 0x72, 0x20, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x7d, 0x4a, 0x58, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12,
+	0x51, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x3f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x2b, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a,
+	0x20, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x64, 0x64, 0x20,
+	0x75, 0x73, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0xf8, 0x07, 0x0a, 0x05, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc4,
+	0x07, 0x92, 0x41, 0xab, 0x07, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x05, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x1a, 0x97, 0x02, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x20, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x20, 0x62, 0x79, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x6f, 0x72, 0x20, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x69,
+	0x72, 0x2e, 0x20, 0x57, 0x69, 0x74, 0x68, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x20, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x20, 0x49, 0x44, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73,
+	0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x6f, 0x2e, 0x20, 0x57,
+	0x69, 0x74, 0x68, 0x20, 0x54, 0x4f, 0x54, 0x50, 0x20, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x6d, 0x66, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d,
+	0x46, 0x41, 0x2e, 0x20, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x6f, 0x66, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x20, 0x68, 0x61, 0x73, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x4a, 0x69, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x62, 0x22, 0x60, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x7b, 0x22, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x4a, 0x57, 0x54, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7d, 0x4a, 0x91, 0x02, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12,
+	0x89, 0x02, 0x0a, 0xc0, 0x01, 0x49, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x20, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x20, 0x55, 0x73, 0x65, 0x72,
+	0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x58, 0x2d, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x64, 0x2d, 0x46, 0x6f, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x2e, 0x20, 0x4f, 0x72, 0x20, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x55, 0x4e, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x20,
+	0x69, 0x73, 0x20, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x45, 0x4d, 0x41, 0x49,
+	0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x20, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x7b, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x78, 0x2d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x72, 0x2d, 0x66, 0x6f, 0x72, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x5d, 0x0a, 0x03, 0x34,
+	0x30, 0x31, 0x12, 0x56, 0x0a, 0x1e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x75, 0x73,
+	0x65, 0x72, 0x20, 0x6f, 0x72, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x20, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x34, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x7d, 0x4a, 0xa3, 0x01, 0x0a, 0x03, 0x34,
+	0x32, 0x39, 0x12, 0x9b, 0x01, 0x0a, 0x45, 0x55, 0x73, 0x65, 0x72, 0x20, 0x72, 0x65, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x20, 0x4d, 0x41, 0x58, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x53,
+	0x5f, 0x50, 0x45, 0x52, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x53, 0x45,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x52, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x3e, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x2c, 0x20, 0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x61, 0x6e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x7d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0xff, 0x04, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xad, 0x04, 0x92, 0x41, 0x83, 0x04, 0x0a, 0x04,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x72, 0x65, 0x73, 0x65, 0x74, 0x1a, 0xd4, 0x01, 0x53,
+	0x65, 0x6e, 0x64, 0x73, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x75, 0x73, 0x65, 0x20,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x72, 0x65, 0x73, 0x65, 0x74, 0x20, 0x6c,
+	0x69, 0x6e, 0x6b, 0x20, 0x74, 0x6f, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x69, 0x66, 0x20,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x75, 0x63,
+	0x68, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x2e, 0x20,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x73, 0x61, 0x6d, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x20, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x20, 0x70, 0x65,
+	0x72, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x62, 0x79, 0x20, 0x50, 0x41, 0x53, 0x53, 0x57,
+	0x4f, 0x52, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c,
+	0x49, 0x4d, 0x49, 0x54, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52,
+	0x44, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x49, 0x4e,
+	0x44, 0x4f, 0x57, 0x4a, 0x1f, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x18, 0x22, 0x16, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x02, 0x7b, 0x7d, 0x4a, 0x49, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x42, 0x0a, 0x10, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22,
+	0x2e, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x7d, 0x4a,
+	0x9f, 0x01, 0x0a, 0x03, 0x34, 0x32, 0x39, 0x12, 0x97, 0x01, 0x0a, 0x50, 0x54, 0x6f, 0x6f, 0x20,
+	0x6d, 0x61, 0x6e, 0x79, 0x20, 0x72, 0x65, 0x73, 0x65, 0x74, 0x20, 0x6c, 0x69, 0x6e, 0x6b, 0x73,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74,
+	0x68, 0x69, 0x73, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2c, 0x20, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x20, 0x74, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20,
+	0x74, 0x6f, 0x20, 0x74, 0x72, 0x79, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x22, 0x43, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x2f, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6f,
+	0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x2c, 0x20,
+	0x74, 0x72, 0x79, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x20, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x22,
+	0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xe7, 0x04, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x73,
+	0x67, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x95, 0x04, 0x92, 0x41, 0xeb, 0x03,
+	0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x16, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x20,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x72, 0x65, 0x73, 0x65, 0x74, 0x1a, 0x91,
+	0x01, 0x53, 0x65, 0x74, 0x73, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x72, 0x65, 0x73, 0x65, 0x74, 0x20, 0x6c, 0x69, 0x6e, 0x6b, 0x2e, 0x20,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x20, 0x6f, 0x6e, 0x63, 0x65,
+	0x2c, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x72, 0x65, 0x73, 0x65, 0x74, 0x20, 0x61, 0x6c,
+	0x6c, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x75, 0x73,
+	0x65, 0x72, 0x20, 0x61, 0x72, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x4a, 0x1f, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x18, 0x22, 0x16, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x02, 0x7b, 0x7d, 0x4a, 0xc1, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0xb9, 0x01, 0x0a, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x2c, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x6c, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x20, 0x75, 0x73, 0x65, 0x64, 0x2e, 0x20, 0x4f, 0x72, 0x20, 0x6e, 0x65,
+	0x77, 0x20, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e,
+	0x20, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x3a, 0x20, 0x22, 0x72, 0x65, 0x73, 0x65, 0x74, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20,
+	0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x52, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x4b,
+	0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x39, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x20, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x2d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x12, 0xf8, 0x05, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xa4, 0x05, 0x92, 0x41, 0xf9, 0x04, 0x0a, 0x04, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0xc1, 0x02, 0x53, 0x65,
+	0x6e, 0x64, 0x73, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x75, 0x73, 0x65, 0x20, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x69, 0x66, 0x20, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x75, 0x63, 0x68, 0x20, 0x75, 0x6e, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x65, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x2e, 0x20, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x69, 0x73,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x75, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x20, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x69, 0x74, 0x20,
+	0x69, 0x73, 0x20, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x77, 0x68, 0x65,
+	0x6e, 0x20, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2e, 0x20, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65,
+	0x64, 0x20, 0x70, 0x65, 0x72, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x62, 0x79, 0x20, 0x45,
+	0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x4a,
+	0x1f, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x18, 0x22, 0x16, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x02, 0x7b, 0x7d,
+	0x4a, 0x49, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x42, 0x0a, 0x10, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x1a, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x7d, 0x4a, 0xa7, 0x01, 0x0a, 0x03,
+	0x34, 0x32, 0x39, 0x12, 0x9f, 0x01, 0x0a, 0x58, 0x54, 0x6f, 0x6f, 0x20, 0x6d, 0x61, 0x6e, 0x79,
+	0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2c, 0x20, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x20, 0x74, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x77,
+	0x68, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x72, 0x79, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e,
+	0x22, 0x43, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x2f, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x74, 0x6f, 0x6f, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x2c, 0x20, 0x74, 0x72, 0x79, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x20, 0x6c, 0x61,
+	0x74, 0x65, 0x72, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x2d,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xfd, 0x03, 0x0a,
+	0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xbd, 0x03, 0x92,
+	0x41, 0x9d, 0x03, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x94, 0x01, 0x4d, 0x61, 0x72, 0x6b, 0x73, 0x20,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x75,
+	0x73, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6c, 0x69, 0x6e,
+	0x6b, 0x2e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x20, 0x6f,
+	0x6e, 0x63, 0x65, 0x2e, 0x20, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x20, 0x6b, 0x65, 0x65, 0x70, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x3d, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x20, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a, 0x1f,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x18, 0x22, 0x16, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x02, 0x7b, 0x7d, 0x4a,
+	0x7d, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x76, 0x0a, 0x29, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20,
+	0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x75,
+	0x73, 0x65, 0x64, 0x22, 0x49, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x35, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x3a, 0x20, 0x22, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x50,
+	0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x49, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x7d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x88, 0x06, 0x0a,
+	0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc8, 0x05, 0x92,
+	0x41, 0xa5, 0x05, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x20, 0x54, 0x4f, 0x54, 0x50, 0x1a, 0xd4, 0x01, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73,
+	0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x20, 0x61, 0x70, 0x70, 0x20, 0x28, 0x52,
+	0x46, 0x43, 0x20, 0x36, 0x32, 0x33, 0x38, 0x2c, 0x20, 0x53, 0x48, 0x41, 0x2d, 0x31, 0x2c, 0x20,
+	0x36, 0x20, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x2c, 0x20, 0x33, 0x30, 0x20, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x29, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x20, 0x69, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6f, 0x74, 0x70, 0x61, 0x75, 0x74,
+	0x68, 0x20, 0x55, 0x52, 0x49, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x51, 0x52, 0x20, 0x63, 0x6f, 0x64,
+	0x65, 0x2e, 0x20, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x20, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x20,
+	0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x2c, 0x20, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x20, 0x74, 0x68,
+	0x65, 0x6e, 0x20, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x63, 0x61,
+	0x6e, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4a, 0xe1, 0x01,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xd9, 0x01, 0x22, 0xd6, 0x01, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xc1, 0x01,
+	0x7b, 0x22, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x4a, 0x42, 0x53, 0x57,
+	0x59, 0x33, 0x44, 0x50, 0x45, 0x48, 0x50, 0x4b, 0x33, 0x50, 0x58, 0x50, 0x4a, 0x42, 0x53, 0x57,
+	0x59, 0x33, 0x44, 0x50, 0x45, 0x48, 0x50, 0x4b, 0x33, 0x50, 0x58, 0x50, 0x22, 0x2c, 0x20, 0x22,
+	0x75, 0x72, 0x69, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x74, 0x70, 0x61, 0x75, 0x74, 0x68, 0x3a, 0x2f,
+	0x2f, 0x74, 0x6f, 0x74, 0x70, 0x2f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x3a, 0x75, 0x73, 0x65, 0x72, 0x40, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63,
+	0x6f, 0x6d, 0x3f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x3d, 0x53, 0x48, 0x41,
+	0x31, 0x26, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x3d, 0x36, 0x26, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x3d, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x26, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x3d, 0x33, 0x30, 0x26, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x3d, 0x4a,
+	0x42, 0x53, 0x57, 0x59, 0x33, 0x44, 0x50, 0x45, 0x48, 0x50, 0x4b, 0x33, 0x50, 0x58, 0x50, 0x4a,
+	0x42, 0x53, 0x57, 0x59, 0x33, 0x44, 0x50, 0x45, 0x48, 0x50, 0x4b, 0x33, 0x50, 0x58, 0x50, 0x22,
+	0x7d, 0x4a, 0x5a, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x53, 0x0a, 0x17, 0x54, 0x4f, 0x54, 0x50,
+	0x20, 0x69, 0x73, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x22, 0x38, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x24, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x74, 0x70, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6c, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x20, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x6b, 0x0a,
+	0x03, 0x34, 0x30, 0x31, 0x12, 0x64, 0x0a, 0x2b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2c,
+	0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x22, 0x35, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x62, 0x6c, 0x61,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06,
+	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01,
+	0x2a, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x66, 0x61, 0x2f, 0x74, 0x6f, 0x74, 0x70,
+	0x2f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0xe7, 0x04, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x6d, 0x73, 0x67, 0x1a,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54,
+	0x4f, 0x54, 0x50, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa5, 0x04, 0x92, 0x41, 0x81, 0x04,
+	0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x20,
+	0x54, 0x4f, 0x54, 0x50, 0x1a, 0x9f, 0x01, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x20, 0x54,
+	0x4f, 0x54, 0x50, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x20, 0x61, 0x70, 0x70, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x20, 0x6f, 0x6e, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2e, 0x20, 0x43, 0x6f, 0x64, 0x65, 0x73,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x68, 0x6f, 0x77, 0x6e, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20,
+	0x6f, 0x6e, 0x63, 0x65, 0x2c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x73, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20,
+	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x4a, 0x4f, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x48, 0x22,
+	0x46, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x32, 0x7b, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x22, 0x6b, 0x37, 0x6d, 0x32, 0x70, 0x2d,
+	0x78, 0x39, 0x71, 0x72, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x33, 0x76, 0x7a, 0x38, 0x2d, 0x6e,
+	0x77, 0x34, 0x63, 0x65, 0x22, 0x5d, 0x7d, 0x4a, 0x7d, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x76,
+	0x0a, 0x45, 0x43, 0x6f, 0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x2c, 0x20, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x73,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20,
+	0x54, 0x4f, 0x54, 0x50, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x2d, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20,
+	0x63, 0x6f, 0x64, 0x65, 0x22, 0x7d, 0x4a, 0x6b, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x64, 0x0a,
+	0x2b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73,
+	0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x35, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x21, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x22, 0x7d, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6d, 0x66, 0x61, 0x2f, 0x74, 0x6f, 0x74, 0x70, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x12, 0x85, 0x06, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x46, 0x41, 0x12,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4d, 0x46,
+	0x41, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc9, 0x05,
+	0x92, 0x41, 0xab, 0x05, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x14, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x20, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x1a, 0xfc, 0x01, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x20, 0x73, 0x74, 0x65, 0x70, 0x20, 0x6f,
+	0x66, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x54, 0x4f, 0x54, 0x50, 0x2e, 0x20, 0x54, 0x61, 0x6b,
+	0x65, 0x73, 0x20, 0x6d, 0x66, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x54, 0x4f, 0x54, 0x50, 0x20, 0x63, 0x6f, 0x64, 0x65,
+	0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x20, 0x63, 0x6f, 0x64,
+	0x65, 0x2c, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x70, 0x61, 0x69, 0x72, 0x20, 0x6c, 0x69, 0x6b, 0x65, 0x20, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x2e, 0x20, 0x45, 0x61, 0x63, 0x68, 0x20, 0x54, 0x4f, 0x54, 0x50, 0x20, 0x63, 0x6f, 0x64, 0x65,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x20, 0x63, 0x6f,
+	0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x20, 0x6f,
+	0x6e, 0x63, 0x65, 0x2c, 0x20, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x20, 0x73,
+	0x74, 0x6f, 0x70, 0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x20, 0x4d, 0x46, 0x41, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d,
+	0x50, 0x54, 0x53, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x4a,
+	0x69, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x62, 0x22, 0x60, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x7b, 0x22,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x20, 0x22, 0x4a, 0x57, 0x54, 0x20, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7d, 0x4a, 0x94, 0x01, 0x0a, 0x03, 0x34,
+	0x30, 0x30, 0x12, 0x8c, 0x01, 0x0a, 0x44, 0x49, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x20, 0x55, 0x73,
+	0x65, 0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x58, 0x2d, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x2d, 0x46, 0x6f, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x30, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x78, 0x2d, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2d, 0x66, 0x6f, 0x72, 0x20, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22,
+	0x7d, 0x4a, 0x8b, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x83, 0x01, 0x0a, 0x52, 0x43, 0x6f,
+	0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x20, 0x6f, 0x72, 0x20, 0x61,
+	0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x75, 0x73, 0x65, 0x64, 0x2c, 0x20, 0x6f, 0x72, 0x20,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x20, 0x6f, 0x72,
+	0x20, 0x6f, 0x75, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x22, 0x2d, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x7d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x66, 0x61, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x89, 0x05, 0x0a, 0x18, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73,
+	0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xb9, 0x04, 0x92, 0x41, 0x8a, 0x04,
+	0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1a, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x20, 0x70, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0xb0, 0x01, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x20, 0x61, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x20, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x20,
+	0x69, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6e,
+	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x28, 0x29, 0x2c, 0x20, 0x69,
+	0x74, 0x73, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x6e,
+	0x74, 0x20, 0x74, 0x6f, 0x20, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b,
+	0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74,
+	0x6f, 0x67, 0x65, 0x74, 0x68, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x63, 0x65, 0x72,
+	0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x4a, 0xb7, 0x01, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xaf, 0x01,
+	0x22, 0xac, 0x01, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x97, 0x01, 0x7b, 0x22, 0x63, 0x65, 0x72, 0x65, 0x6d, 0x6f,
+	0x6e, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32,
+	0x6d, 0x58, 0x31, 0x72, 0x32, 0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x2e, 0x38, 0x63, 0x34,
+	0x51, 0x62, 0x57, 0x31, 0x6e, 0x33, 0x6f, 0x59, 0x74, 0x39, 0x48, 0x6b, 0x32, 0x73, 0x56, 0x64,
+	0x37, 0x72, 0x45, 0x36, 0x75, 0x4c, 0x35, 0x6d, 0x5a, 0x30, 0x61, 0x50, 0x71, 0x34, 0x78, 0x4a,
+	0x66, 0x47, 0x68, 0x4e, 0x73, 0x31, 0x43, 0x77, 0x22, 0x2c, 0x20, 0x22, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x2e, 0x2e, 0x2e, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x70, 0x49, 0x64, 0x22, 0x3a,
+	0x20, 0x22, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x7d, 0x7d, 0x7d, 0x4a,
+	0x6b, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x64, 0x0a, 0x2b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x35, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x7b, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x62,
+	0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x62, 0x0c, 0x0a, 0x0a,
+	0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65,
+	0x79, 0x73, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0xe2, 0x05, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0xf6, 0x04, 0x92, 0x41, 0xc6, 0x04, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1b,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x20, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x20, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x77, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x20, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x61, 0x76, 0x65, 0x73, 0x20, 0x70, 0x61, 0x73,
+	0x73, 0x6b, 0x65, 0x79, 0x2e, 0x20, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x20, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x57, 0x45, 0x42, 0x41,
+	0x55, 0x54, 0x48, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x20, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x4a, 0x26, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x1f, 0x22, 0x1d, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0x09, 0x7b, 0x22, 0x69, 0x64, 0x22, 0x3a, 0x20, 0x31, 0x7d, 0x4a, 0x9c, 0x01, 0x0a,
+	0x03, 0x34, 0x30, 0x30, 0x12, 0x94, 0x01, 0x0a, 0x49, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e,
+	0x79, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x65, 0x64, 0x22, 0x47, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x33, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x3a, 0x20, 0x22, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x20, 0x63, 0x65, 0x72, 0x65, 0x6d,
+	0x6f, 0x6e, 0x79, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f,
+	0x72, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x6b, 0x0a, 0x03, 0x34,
+	0x30, 0x31, 0x12, 0x64, 0x0a, 0x2b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x22, 0x35, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a,
+	0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x62, 0x6c, 0x61, 0x63, 0x6b,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x66, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12,
+	0x5f, 0x0a, 0x1d, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6c,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x22, 0x3e, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6c, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x22, 0x7d,
+	0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x73, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0xd6, 0x03, 0x0a, 0x11, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x8d, 0x03, 0x92, 0x41, 0xe5, 0x02, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x13, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x20, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x20,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x8d, 0x01, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x20, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x2e, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x2e, 0x67,
+	0x65, 0x74, 0x28, 0x29, 0x2c, 0x20, 0x69, 0x74, 0x73, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x74,
+	0x6f, 0x67, 0x65, 0x74, 0x68, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x63, 0x65, 0x72,
+	0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x4a, 0xb7, 0x01, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xaf, 0x01,
+	0x22, 0xac, 0x01, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x97, 0x01, 0x7b, 0x22, 0x63, 0x65, 0x72, 0x65, 0x6d, 0x6f,
+	0x6e, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32,
+	0x6d, 0x58, 0x31, 0x72, 0x32, 0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x2e, 0x38, 0x63, 0x34,
+	0x51, 0x62, 0x57, 0x31, 0x6e, 0x33, 0x6f, 0x59, 0x74, 0x39, 0x48, 0x6b, 0x32, 0x73, 0x56, 0x64,
+	0x37, 0x72, 0x45, 0x36, 0x75, 0x4c, 0x35, 0x6d, 0x5a, 0x30, 0x61, 0x50, 0x71, 0x34, 0x78, 0x4a,
+	0x66, 0x47, 0x68, 0x4e, 0x73, 0x31, 0x43, 0x77, 0x22, 0x2c, 0x20, 0x22, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x2e, 0x2e, 0x2e, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x70, 0x49, 0x64, 0x22, 0x3a,
+	0x20, 0x22, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x7d, 0x7d, 0x7d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
+	0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2f, 0x62, 0x65,
+	0x67, 0x69, 0x6e, 0x12, 0xa8, 0x05, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0xda, 0x04, 0x92, 0x41, 0xb1, 0x04, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x14,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x20, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x20, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x20, 0x61, 0x73, 0x73,
+	0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65,
+	0x79, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x69, 0x72, 0x20, 0x6c, 0x69, 0x6b, 0x65, 0x20, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x2e, 0x20, 0x55, 0x73, 0x65, 0x72, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x54, 0x4f, 0x54, 0x50, 0x20, 0x69, 0x73, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x4a, 0x69, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
+	0x62, 0x22, 0x60, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x7b, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0x3a, 0x20, 0x22, 0x4a, 0x57, 0x54, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x3a,
+	0x20, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x7d, 0x4a, 0xa3, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x9b, 0x01, 0x0a, 0x50,
+	0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x20,
+	0x6f, 0x72, 0x20, 0x55, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x72,
+	0x20, 0x58, 0x2d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x2d, 0x46, 0x6f, 0x72,
+	0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64,
+	0x22, 0x47, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x33, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x20, 0x63, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e,
+	0x79, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x8d, 0x01, 0x0a, 0x03, 0x34, 0x30,
+	0x31, 0x12, 0x85, 0x01, 0x0a, 0x49, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x70, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x20, 0x6f, 0x72, 0x20, 0x69, 0x74, 0x73, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x20, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x20, 0x64, 0x69, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x67, 0x72, 0x6f, 0x77, 0x22,
+	0x38, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x24, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22,
+	0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a,
+	0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79,
+	0x73, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0xb7,
+	0x06, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x6c, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c,
+	0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x23, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0xd4, 0x05, 0x92, 0x41, 0xae, 0x05, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x18,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x20, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65,
+	0x73, 0x73, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0xae, 0x02, 0x53, 0x65, 0x6e, 0x64, 0x73,
+	0x20, 0x6f, 0x6e, 0x65, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x20, 0x6c, 0x69, 0x6e, 0x6b, 0x20, 0x74, 0x6f,
+	0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x61, 0x6e, 0x79, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e, 0x27, 0x74, 0x20,
+	0x74, 0x65, 0x6c, 0x6c, 0x20, 0x69, 0x66, 0x20, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x2e, 0x20, 0x43, 0x6f, 0x64, 0x65, 0x20, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x55, 0x73, 0x65, 0x72, 0x2d, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x2c, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20,
+	0x63, 0x6f, 0x64, 0x65, 0x2e, 0x20, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6f, 0x6e, 0x65,
+	0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x69, 0x73, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65,
+	0x64, 0x20, 0x62, 0x79, 0x20, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x4c, 0x45, 0x53,
+	0x53, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x20, 0x70, 0x65, 0x72,
+	0x20, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x41,
+	0x54, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x4a, 0x43, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x3c, 0x22, 0x3a, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x7b, 0x22, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x22, 0x3a, 0x20, 0x22, 0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32,
+	0x6d, 0x58, 0x31, 0x72, 0x32, 0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x22, 0x7d, 0x4a, 0x7a,
+	0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x73, 0x0a, 0x41, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x69,
+	0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x55, 0x73, 0x65,
+	0x72, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x58, 0x2d, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x2d, 0x46, 0x6f, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22, 0x2e, 0x0a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1a,
+	0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x7d, 0x4a, 0x99, 0x01, 0x0a, 0x03, 0x34,
+	0x32, 0x39, 0x12, 0x91, 0x01, 0x0a, 0x4a, 0x54, 0x6f, 0x6f, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20,
+	0x63, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20,
+	0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2c, 0x20,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x20, 0x74, 0x65, 0x6c, 0x6c, 0x73, 0x20,
+	0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x72, 0x79, 0x20, 0x61, 0x67, 0x61, 0x69,
+	0x6e, 0x22, 0x43, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x2f, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a,
+	0x20, 0x22, 0x74, 0x6f, 0x6f, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x2c, 0x20, 0x74, 0x72, 0x79, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x20, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22,
+	0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65,
+	0x73, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0xdd, 0x06, 0x0a, 0x19, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73,
+	0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c,
+	0x65, 0x73, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x81, 0x06, 0x92, 0x41, 0xd8, 0x05, 0x0a, 0x04, 0x41, 0x75, 0x74,
+	0x68, 0x12, 0x1b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x99,
+	0x02, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x66, 0x72, 0x6f,
+	0x6d, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x20, 0x70, 0x61, 0x69, 0x72, 0x20, 0x6c, 0x69, 0x6b, 0x65, 0x20, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x2e, 0x20, 0x4d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20,
+	0x55, 0x73, 0x65, 0x72, 0x2d, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x20, 0x61, 0x73, 0x20, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x2e, 0x20, 0x43, 0x6f, 0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73,
+	0x74, 0x6f, 0x70, 0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x20, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x4c, 0x45, 0x53, 0x53, 0x5f,
+	0x4d, 0x41, 0x58, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x53, 0x20, 0x77, 0x72, 0x6f,
+	0x6e, 0x67, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2e, 0x20, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20,
+	0x6f, 0x66, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x62, 0x65, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x20,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2e, 0x20, 0x57, 0x69, 0x74, 0x68, 0x20, 0x54,
+	0x4f, 0x54, 0x50, 0x20, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x6c, 0x79,
+	0x20, 0x6d, 0x66, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x20, 0x69,
+	0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x4a, 0x69, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x62, 0x22, 0x60, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x7b, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x22, 0x3a, 0x20, 0x22, 0x4a, 0x57, 0x54, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x22, 0x3a, 0x20, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x7d, 0x4a, 0x94, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x8c, 0x01,
+	0x0a, 0x44, 0x49, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x20, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x20, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x20, 0x55, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x58, 0x2d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x64, 0x2d, 0x46, 0x6f, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x7b, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x78, 0x2d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x72, 0x2d, 0x66, 0x6f, 0x72, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x94, 0x01, 0x0a,
+	0x03, 0x34, 0x30, 0x31, 0x12, 0x8c, 0x01, 0x0a, 0x47, 0x43, 0x6f, 0x64, 0x65, 0x20, 0x69, 0x73,
+	0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x2c,
+	0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x72,
+	0x20, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x6e,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x55, 0x73, 0x65, 0x72, 0x2d, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x22, 0x41, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x2d, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x8f, 0x05, 0x0a, 0x09, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0xd3, 0x04, 0x92, 0x41, 0xb7, 0x04, 0x0a, 0x04, 0x41, 0x75, 0x74,
+	0x68, 0x12, 0x1c, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x1a,
+	0x8a, 0x02, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x20, 0x32, 0x2e, 0x31, 0x20, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x66,
+	0x6c, 0x6f, 0x77, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x79, 0x20, 0x50, 0x4b, 0x43, 0x45, 0x20, 0x28, 0x53, 0x32, 0x35, 0x36, 0x29, 0x2e, 0x20,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73,
+	0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x4f,
+	0x41, 0x55, 0x54, 0x48, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x55, 0x52, 0x4c, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x70,
+	0x61, 0x67, 0x65, 0x20, 0x6c, 0x6f, 0x67, 0x73, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x69, 0x6e,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x20, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x20, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x61, 0x72,
+	0x65, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x4a, 0x56, 0x0a, 0x03,
+	0x33, 0x30, 0x32, 0x12, 0x4f, 0x0a, 0x35, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x20,
+	0x74, 0x6f, 0x20, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x6f,
+	0x72, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72,
+	0x69, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x16, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x02, 0x7b, 0x7d, 0x4a, 0xab, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0xa3, 0x01, 0x0a,
+	0x55, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x75, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75,
+	0x72, 0x69, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x69, 0x74, 0x2c, 0x20, 0x62, 0x72, 0x6f,
+	0x77, 0x73, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x7b, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f,
+	0x75, 0x72, 0x69, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x22, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0xfd, 0x07, 0x0a, 0x14, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xab, 0x07, 0x92,
+	0x41, 0x8c, 0x07, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x15, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0xa9, 0x02, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x2d, 0x70, 0x61, 0x72, 0x74, 0x79, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x20,
+	0x69, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20,
+	0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x2e, 0x20, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x73, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x75, 0x73, 0x65, 0x20,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x6f,
+	0x64, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x4f, 0x41, 0x55,
+	0x54, 0x48, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x54, 0x4c, 0x20, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x2c, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x68, 0x61, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x6e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x65, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x4a, 0xab, 0x01, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0xa3, 0x01, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x8b, 0x01, 0x7b,
+	0x22, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x22, 0x3a, 0x20, 0x22,
+	0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74,
+	0x3a, 0x38, 0x30, 0x38, 0x32, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x3f, 0x63,
+	0x6f, 0x64, 0x65, 0x3d, 0x4a, 0x78, 0x33, 0x76, 0x30, 0x61, 0x39, 0x54, 0x51, 0x32, 0x6d, 0x58,
+	0x31, 0x72, 0x32, 0x62, 0x4b, 0x63, 0x4c, 0x30, 0x70, 0x41, 0x2e, 0x38, 0x63, 0x34, 0x51, 0x62,
+	0x57, 0x31, 0x6e, 0x33, 0x6f, 0x59, 0x74, 0x39, 0x48, 0x6b, 0x32, 0x73, 0x56, 0x64, 0x37, 0x72,
+	0x45, 0x36, 0x75, 0x4c, 0x35, 0x6d, 0x5a, 0x30, 0x61, 0x50, 0x71, 0x34, 0x78, 0x4a, 0x66, 0x47,
+	0x68, 0x4e, 0x73, 0x31, 0x43, 0x77, 0x26, 0x73, 0x74, 0x61, 0x74, 0x65, 0x3d, 0x61, 0x66, 0x30,
+	0x69, 0x66, 0x6a, 0x73, 0x6c, 0x64, 0x6b, 0x6a, 0x22, 0x7d, 0x4a, 0x77, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x70, 0x0a, 0x3a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x75,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x69, 0x74, 0x22,
+	0x32, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22,
+	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x22, 0x7d, 0x4a, 0x85, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x7e, 0x0a, 0x45, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x20,
+	0x6f, 0x72, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x69,
+	0x74, 0x73, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x22, 0x35, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x62, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x83, 0x01, 0x0a, 0x03,
+	0x34, 0x30, 0x33, 0x12, 0x7c, 0x0a, 0x27, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x20, 0x77, 0x61, 0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x51,
+	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x12, 0x3d, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x6f,
+	0x6e, 0x6c, 0x79, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x2d, 0x70, 0x61, 0x72, 0x74, 0x79, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x7d, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0xfb, 0x09, 0x0a, 0x05, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0xc9, 0x09, 0x92,
+	0x41, 0xae, 0x09, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x14, 0x4f, 0x41, 0x75, 0x74, 0x68,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x1a,
+	0xfa, 0x03, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x2e, 0x20, 0x46, 0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2c, 0x20,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2c, 0x20, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x63, 0x6f, 0x64, 0x65,
+	0x20, 0x69, 0x73, 0x20, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x63,
+	0x65, 0x2e, 0x20, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x6c,
+	0x79, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x73, 0x20, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x74, 0x20, 0x77, 0x61,
+	0x73, 0x20, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x2e, 0x20, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x42, 0x61, 0x73, 0x69, 0x63, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x6f,
+	0x72, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2e,
+	0x20, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x77, 0x77, 0x77, 0x2d, 0x66, 0x6f, 0x72, 0x6d, 0x2d,
+	0x75, 0x72, 0x6c, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x6a, 0x73,
+	0x6f, 0x6e, 0x20, 0x62, 0x6f, 0x64, 0x79, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20,
+	0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20,
+	0x4f, 0x41, 0x75, 0x74, 0x68, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x32, 0x21, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2d, 0x77, 0x77, 0x77, 0x2d,
+	0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x75, 0x72, 0x6c, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x32,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x4a, 0xd2, 0x01, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xca, 0x01, 0x22, 0xc7, 0x01, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0xb2, 0x01, 0x7b, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x4a, 0x57, 0x54, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x22, 0x2c,
+	0x20, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x22, 0x3a, 0x20, 0x39,
+	0x30, 0x30, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x22, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x3a, 0x20, 0x22, 0x4a, 0x57, 0x54, 0x20, 0x69, 0x64, 0x20, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x64, 0x22, 0x7d, 0x4a, 0xe0, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0xd8,
+	0x01, 0x0a, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x2c, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20,
+	0x75, 0x73, 0x65, 0x64, 0x2c, 0x20, 0x50, 0x4b, 0x43, 0x45, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x71, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x5d, 0x7b, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x6e,
+	0x27, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0x7d, 0x4a, 0xa5, 0x01, 0x0a, 0x03, 0x34, 0x30,
+	0x31, 0x12, 0x9d, 0x01, 0x0a, 0x35, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20,
+	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x74, 0x73, 0x20, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x20, 0x69, 0x73, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x22, 0x64, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x50, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x3a, 0x20, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22,
+	0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x6f, 0x61, 0x75,
+	0x74, 0x68, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0xaf, 0x09, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
+	0x73, 0x67, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xf0, 0x08, 0x92, 0x41, 0xd3, 0x08, 0x0a, 0x04,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x1a, 0xfc, 0x01, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x67, 0x65,
+	0x74, 0x73, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2c, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73,
+	0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x6f,
+	0x6e, 0x63, 0x65, 0x2e, 0x20, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x20, 0x55, 0x52, 0x49, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x64, 0x20, 0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x2e, 0x20, 0x4c,
+	0x69, 0x66, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x69, 0x6e,
+	0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x2c, 0x20, 0x30, 0x20, 0x6d, 0x65, 0x61, 0x6e,
+	0x73, 0x20, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x4c, 0x49, 0x46, 0x45, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x4c,
+	0x49, 0x46, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4a, 0xf5, 0x02, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0xed, 0x02, 0x22, 0xea, 0x02, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xd5, 0x02, 0x7b, 0x22, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x3a, 0x20, 0x22, 0x77, 0x65, 0x62, 0x2d, 0x61, 0x70,
+	0x70, 0x22, 0x2c, 0x20, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x71, 0x38, 0x59, 0x62, 0x31, 0x6e, 0x58, 0x32, 0x6b, 0x44,
+	0x34, 0x73, 0x56, 0x36, 0x6d, 0x5a, 0x30, 0x70, 0x4c, 0x33, 0x74, 0x52, 0x37, 0x77, 0x45, 0x39,
+	0x75, 0x4a, 0x35, 0x68, 0x47, 0x66, 0x31, 0x63, 0x41, 0x32, 0x62, 0x4e, 0x34, 0x78, 0x4b, 0x38,
+	0x6f, 0x22, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x22, 0x3a, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x22, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x20,
+	0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5d,
+	0x2c, 0x20, 0x22, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x73,
+	0x22, 0x3a, 0x20, 0x5b, 0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38, 0x30, 0x38, 0x32, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x22, 0x5d, 0x2c, 0x20, 0x22, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x22, 0x5d, 0x2c, 0x20, 0x22, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x36, 0x30, 0x30, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x30, 0x22,
+	0x2c, 0x20, 0x22, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x3a, 0x20, 0x66, 0x61,
+	0x6c, 0x73, 0x65, 0x2c, 0x20, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x22, 0x3a, 0x20, 0x22, 0x31, 0x37, 0x35, 0x31, 0x35, 0x34, 0x33, 0x37, 0x34, 0x33, 0x22, 0x7d,
+	0x4a, 0x75, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x6e, 0x0a, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x3b, 0x7b, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x20,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x20, 0x75, 0x72, 0x69, 0x22, 0x7d, 0x4a, 0x61, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x5a,
+	0x0a, 0x24, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x7b, 0x22, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6b, 0x65, 0x79, 0x22, 0x7d, 0x4a, 0x71, 0x0a, 0x03, 0x34, 0x30,
+	0x33, 0x12, 0x6a, 0x0a, 0x30, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x61, 0x70, 0x69, 0x20, 0x69,
+	0x73, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x65, 0x63, 0x61, 0x75,
+	0x73, 0x65, 0x20, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x20, 0x69, 0x73, 0x20,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x36, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x7b, 0x22, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x61, 0x70, 0x69, 0x20,
+	0x69, 0x73, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x62, 0x0a,
+	0x03, 0x34, 0x30, 0x39, 0x12, 0x5b, 0x0a, 0x21, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x73, 0x75, 0x63, 0x68, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x7b,
+	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22,
+	0x7d, 0x62, 0x0e, 0x0a, 0x0c, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xe6, 0x07, 0x0a, 0x0c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa7, 0x07, 0x92, 0x41, 0xfe, 0x06,
+	0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x4f,
+	0x41, 0x75, 0x74, 0x68, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x1a, 0x86, 0x01, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x20, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2c, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x20, 0x55, 0x52, 0x49,
+	0x73, 0x2c, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x69,
+	0x66, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x20, 0x4e, 0x65, 0x77, 0x20, 0x6c, 0x69, 0x66, 0x65, 0x20, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4a, 0xb5, 0x02, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0xad, 0x02, 0x22,
+	0xaa, 0x02, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x95, 0x02, 0x7b, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x22, 0x3a, 0x20, 0x22, 0x77, 0x65, 0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x2c, 0x20,
+	0x22, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x3a, 0x20,
+	0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x22, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5d, 0x2c, 0x20, 0x22, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x22, 0x3a, 0x20, 0x5b,
+	0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73,
+	0x74, 0x3a, 0x38, 0x30, 0x38, 0x32, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22,
+	0x5d, 0x2c, 0x20, 0x22, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x22, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x64, 0x22, 0x5d, 0x2c, 0x20, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x36, 0x30,
+	0x30, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x6c, 0x69, 0x66,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x30, 0x22, 0x2c, 0x20, 0x22, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x3a, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c,
+	0x20, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x3a, 0x20, 0x22,
+	0x31, 0x37, 0x35, 0x31, 0x35, 0x34, 0x33, 0x37, 0x34, 0x33, 0x22, 0x7d, 0x4a, 0x6c, 0x0a, 0x03,
+	0x34, 0x30, 0x30, 0x12, 0x65, 0x0a, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x22, 0x46, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x32, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x3a, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x20, 0x75, 0x72, 0x69, 0x20, 0x77, 0x65, 0x62, 0x2d, 0x61, 0x70, 0x70, 0x2f,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x7d, 0x4a, 0x61, 0x0a, 0x03, 0x34, 0x30,
+	0x31, 0x12, 0x5a, 0x0a, 0x24, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x69,
+	0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x20, 0x6f,
+	0x72, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x7b,
+	0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6b, 0x65, 0x79, 0x22, 0x7d, 0x4a, 0x71, 0x0a,
+	0x03, 0x34, 0x30, 0x33, 0x12, 0x6a, 0x0a, 0x30, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x61, 0x70,
+	0x69, 0x20, 0x69, 0x73, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x65,
+	0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x20,
+	0x69, 0x73, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x36, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x7b, 0x22,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x61,
+	0x70, 0x69, 0x20, 0x69, 0x73, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x7d,
+	0x4a, 0x4c, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x45, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x31, 0x0a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12,
+	0x1d, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x7d, 0x62, 0x0e,
+	0x0a, 0x0c, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x00, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x1a, 0x1a, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0xa0, 0x08, 0x0a, 0x12, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xd5,
+	0x07, 0x92, 0x41, 0x9e, 0x07, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x14, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x1a, 0x69, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x20, 0x6e, 0x65, 0x77,
+	0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x69, 0x74, 0x20, 0x6f, 0x6e,
+	0x63, 0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x6c, 0x64, 0x20, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x20, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x4a, 0xf5, 0x02, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0xed, 0x02, 0x22, 0xea, 0x02, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0xd5, 0x02, 0x7b,
+	0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x3a, 0x20, 0x22, 0x77, 0x65,
+	0x62, 0x2d, 0x61, 0x70, 0x70, 0x22, 0x2c, 0x20, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x71, 0x38, 0x59, 0x62, 0x31, 0x6e,
+	0x58, 0x32, 0x6b, 0x44, 0x34, 0x73, 0x56, 0x36, 0x6d, 0x5a, 0x30, 0x70, 0x4c, 0x33, 0x74, 0x52,
+	0x37, 0x77, 0x45, 0x39, 0x75, 0x4a, 0x35, 0x68, 0x47, 0x66, 0x31, 0x63, 0x41, 0x32, 0x62, 0x4e,
+	0x34, 0x78, 0x4b, 0x38, 0x6f, 0x22, 0x2c, 0x20, 0x22, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x3a, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x22, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x22, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x5d, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f,
+	0x75, 0x72, 0x69, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x22, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38, 0x30, 0x38, 0x32, 0x2f, 0x63,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x5d, 0x2c, 0x20, 0x22, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x22, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x64, 0x22, 0x5d, 0x2c,
+	0x20, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x36, 0x30, 0x30, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x30, 0x22, 0x2c, 0x20, 0x22, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
+	0x3a, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x20, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x37, 0x35, 0x31, 0x35, 0x34, 0x33, 0x37,
+	0x34, 0x33, 0x22, 0x7d, 0x4a, 0x69, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x62, 0x0a, 0x22, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x68, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x22, 0x3c, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a,
+	0x20, 0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20,
+	0x68, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x7d, 0x4a,
+	0x61, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x5a, 0x0a, 0x24, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20,
+	0x6b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x32,
+	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x12, 0x1e, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6b, 0x65, 0x79,
+	0x22, 0x7d, 0x4a, 0x71, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x6a, 0x0a, 0x30, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x20, 0x61, 0x70, 0x69, 0x20, 0x69, 0x73, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x20, 0x62, 0x65, 0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x41, 0x44, 0x4d, 0x49, 0x4e,
+	0x5f, 0x4b, 0x45, 0x59, 0x20, 0x69, 0x73, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x36, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0x22, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x20, 0x61, 0x70, 0x69, 0x20, 0x69, 0x73, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x22, 0x7d, 0x4a, 0x4c, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x45, 0x0a, 0x10,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x22, 0x31, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20,
+	0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x22, 0x7d, 0x62, 0x0e, 0x0a, 0x0c, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x65,
+	0x79, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x2d,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0xe6, 0x04, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
+	0x73, 0x67, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xa2, 0x04, 0x92, 0x41, 0xf1,
+	0x03, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x14, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x20, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x1a, 0x7e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x6e, 0x6f, 0x20, 0x6c, 0x6f, 0x6e,
+	0x67, 0x65, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x20, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2c, 0x20, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x63, 0x6f,
+	0x64, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x20, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x20, 0x6c, 0x69, 0x76, 0x65, 0x20, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x20, 0x74, 0x68, 0x65, 0x79, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x4a, 0x1f, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x18, 0x22, 0x16, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x02, 0x7b, 0x7d, 0x4a, 0x61,
+	0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x5a, 0x0a, 0x24, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6b,
+	0x65, 0x79, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x32, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0x1e, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6b, 0x65, 0x79, 0x22,
+	0x7d, 0x4a, 0x71, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x6a, 0x0a, 0x30, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x20, 0x61, 0x70, 0x69, 0x20, 0x69, 0x73, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x20, 0x62, 0x65, 0x63, 0x61, 0x75, 0x73, 0x65, 0x20, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f,
+	0x4b, 0x45, 0x59, 0x20, 0x69, 0x73, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x36, 0x0a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x12, 0x22, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x20, 0x61, 0x70, 0x69, 0x20, 0x69, 0x73, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x22, 0x7d, 0x4a, 0x4c, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x45, 0x0a, 0x10, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22,
+	0x31, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x22, 0x7d, 0x62, 0x0e, 0x0a, 0x0c, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x65, 0x79,
+	0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42,
+	0xab, 0x03, 0x92, 0x41, 0x9f, 0x03, 0x12, 0x8c, 0x02, 0x0a, 0x10, 0x4d, 0x45, 0x44, 0x4f, 0x44,
+	0x53, 0x20, 0x54, 0x45, 0x53, 0x54, 0x20, 0x54, 0x41, 0x53, 0x4b, 0x12, 0xac, 0x01, 0xd0, 0xad,
+	0xd1, 0x82, 0xd0, 0xbe, 0x20, 0xd1, 0x82, 0xd0, 0xb5, 0xd1, 0x81, 0xd1, 0x82, 0xd0, 0xbe, 0xd0,
+	0xb2, 0xd0, 0xbe, 0xd0, 0xb5, 0x20, 0xd0, 0xb7, 0xd0, 0xb0, 0xd0, 0xb4, 0xd0, 0xb0, 0xd0, 0xbd,
+	0xd0, 0xb8, 0xd0, 0xb5, 0x20, 0xd0, 0xb2, 0xd0, 0xba, 0xd0, 0xbb, 0xd1, 0x8e, 0xd1, 0x87, 0xd0,
+	0xb0, 0xd0, 0xb5, 0xd1, 0x82, 0xd1, 0x81, 0xd1, 0x8f, 0x20, 0xd0, 0xb2, 0x20, 0xd1, 0x81, 0xd0,
+	0xb5, 0xd0, 0xb1, 0xd1, 0x8f, 0x20, 0xd0, 0xbd, 0xd0, 0xb0, 0xd0, 0xb1, 0xd0, 0xbe, 0xd1, 0x80,
+	0x20, 0xd1, 0x84, 0xd1, 0x83, 0xd0, 0xbd, 0xd0, 0xba, 0xd1, 0x86, 0xd0, 0xb8, 0xd0, 0xb9, 0x2c,
+	0x20, 0xd1, 0x80, 0xd0, 0xb5, 0xd0, 0xb0, 0xd0, 0xbb, 0xd0, 0xb8, 0xd0, 0xb7, 0xd1, 0x83, 0xd1,
+	0x8e, 0xd1, 0x89, 0xd0, 0xb8, 0xd1, 0x85, 0x20, 0xd1, 0x87, 0xd0, 0xb0, 0xd1, 0x81, 0xd1, 0x82,
+	0xd1, 0x8c, 0x20, 0xd1, 0x81, 0xd0, 0xb5, 0xd1, 0x80, 0xd0, 0xb2, 0xd0, 0xb8, 0xd1, 0x81, 0xd0,
+	0xb0, 0x20, 0xd0, 0xb0, 0xd1, 0x83, 0xd1, 0x82, 0xd0, 0xb5, 0xd0, 0xbd, 0xd1, 0x84, 0xd0, 0xb8,
+	0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x86, 0xd0, 0xb8, 0xd0, 0xb8, 0x22, 0x44, 0x0a, 0x0e, 0x59, 0x75,
+	0x6e, 0x75, 0x73, 0x6f, 0x76, 0x20, 0x52, 0x75, 0x73, 0x6c, 0x61, 0x6e, 0x12, 0x1c, 0x68, 0x74,
+	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x72, 0x69, 0x73, 0x73, 0x6f, 0x63, 0x68, 0x65, 0x6b, 0x1a, 0x14, 0x72, 0x75, 0x73, 0x6c,
+	0x61, 0x6e, 0x79, 0x6e, 0x79, 0x73, 0x6f, 0x76, 0x40, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x72, 0x75,
+	0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x67, 0x0a, 0x38,
+	0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x08, 0x02, 0x12, 0x19,
+	0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x75, 0x74,
+	0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x0b, 0x58, 0x2d, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x2d, 0x4b, 0x65, 0x79, 0x20, 0x02, 0x0a, 0x2b, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x12, 0x21, 0x08, 0x02, 0x12, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x02, 0x5a, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -586,32 +5056,129 @@ func file_Proto_auth_proto_rawDescGZIP() []byte {
 	return file_Proto_auth_proto_rawDescData
 }
 
-var file_Proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_Proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_Proto_auth_proto_goTypes = []any{
-	(*GetTokensMsg)(nil),       // 0: proto.GetTokens_msg
-	(*RefreshTokensMsg)(nil),   // 1: proto.RefreshTokens_msg
-	(*GetTokensReply)(nil),     // 2: proto.GetTokens_reply
-	(*RefreshTokensReply)(nil), // 3: proto.RefreshTokens_reply
-	(*GetGUIDReply)(nil),       // 4: proto.GetGUID_reply
-	(*AddUserReply)(nil),       // 5: proto.AddUser_reply
-	(*emptypb.Empty)(nil),      // 6: google.protobuf.Empty
+	(*GetTokensMsg)(nil),                   // 0: proto.GetTokens_msg
+	(*RefreshTokensMsg)(nil),               // 1: proto.RefreshTokens_msg
+	(*GetTokensReply)(nil),                 // 2: proto.GetTokens_reply
+	(*RefreshTokensReply)(nil),             // 3: proto.RefreshTokens_reply
+	(*GetGUIDReply)(nil),                   // 4: proto.GetGUID_reply
+	(*AddUserReply)(nil),                   // 5: proto.AddUser_reply
+	(*IntrospectTokenMsg)(nil),             // 6: proto.IntrospectToken_msg
+	(*RevokeTokenMsg)(nil),                 // 7: proto.RevokeToken_msg
+	(*SessionInfo)(nil),                    // 8: proto.Session_info
+	(*ListSessionsReply)(nil),              // 9: proto.ListSessions_reply
+	(*RevokeSessionMsg)(nil),               // 10: proto.RevokeSession_msg
+	(*RevokeAllSessionsMsg)(nil),           // 11: proto.RevokeAllSessions_msg
+	(*RevokeAllSessionsReply)(nil),         // 12: proto.RevokeAllSessions_reply
+	(*RegisterMsg)(nil),                    // 13: proto.Register_msg
+	(*RegisterReply)(nil),                  // 14: proto.Register_reply
+	(*LoginMsg)(nil),                       // 15: proto.Login_msg
+	(*RequestPasswordResetMsg)(nil),        // 16: proto.RequestPasswordReset_msg
+	(*ConfirmPasswordResetMsg)(nil),        // 17: proto.ConfirmPasswordReset_msg
+	(*SendVerificationEmailMsg)(nil),       // 18: proto.SendVerificationEmail_msg
+	(*VerifyEmailMsg)(nil),                 // 19: proto.VerifyEmail_msg
+	(*EnrollTOTPReply)(nil),                // 20: proto.EnrollTOTP_reply
+	(*ConfirmTOTPMsg)(nil),                 // 21: proto.ConfirmTOTP_msg
+	(*ConfirmTOTPReply)(nil),               // 22: proto.ConfirmTOTP_reply
+	(*VerifyMFAMsg)(nil),                   // 23: proto.VerifyMFA_msg
+	(*BeginPasskeyReply)(nil),              // 24: proto.BeginPasskey_reply
+	(*FinishPasskeyRegistrationMsg)(nil),   // 25: proto.FinishPasskeyRegistration_msg
+	(*FinishPasskeyRegistrationReply)(nil), // 26: proto.FinishPasskeyRegistration_reply
+	(*FinishPasskeyLoginMsg)(nil),          // 27: proto.FinishPasskeyLogin_msg
+	(*StartPasswordlessLoginMsg)(nil),      // 28: proto.StartPasswordlessLogin_msg
+	(*StartPasswordlessLoginReply)(nil),    // 29: proto.StartPasswordlessLogin_reply
+	(*CompletePasswordlessLoginMsg)(nil),   // 30: proto.CompletePasswordlessLogin_msg
+	(*AuthorizeMsg)(nil),                   // 31: proto.Authorize_msg
+	(*ApproveAuthorizationReply)(nil),      // 32: proto.ApproveAuthorization_reply
+	(*TokenMsg)(nil),                       // 33: proto.Token_msg
+	(*CreateClientMsg)(nil),                // 34: proto.CreateClient_msg
+	(*UpdateClientMsg)(nil),                // 35: proto.UpdateClient_msg
+	(*RotateClientSecretMsg)(nil),          // 36: proto.RotateClientSecret_msg
+	(*DisableClientMsg)(nil),               // 37: proto.DisableClient_msg
+	(*ClientReply)(nil),                    // 38: proto.Client_reply
+	(*structpb.Struct)(nil),                // 39: google.protobuf.Struct
+	(*emptypb.Empty)(nil),                  // 40: google.protobuf.Empty
+	(*httpbody.HttpBody)(nil),              // 41: google.api.HttpBody
 }
 var file_Proto_auth_proto_depIdxs = []int32{
-	0, // 0: proto.Auth.GetTokens:input_type -> proto.GetTokens_msg
-	1, // 1: proto.Auth.RefreshTokens:input_type -> proto.RefreshTokens_msg
-	6, // 2: proto.Auth.GetGUID:input_type -> google.protobuf.Empty
-	6, // 3: proto.Auth.Logout:input_type -> google.protobuf.Empty
-	6, // 4: proto.Auth.AddUser:input_type -> google.protobuf.Empty
-	2, // 5: proto.Auth.GetTokens:output_type -> proto.GetTokens_reply
-	3, // 6: proto.Auth.RefreshTokens:output_type -> proto.RefreshTokens_reply
-	4, // 7: proto.Auth.GetGUID:output_type -> proto.GetGUID_reply
-	6, // 8: proto.Auth.Logout:output_type -> google.protobuf.Empty
-	5, // 9: proto.Auth.AddUser:output_type -> proto.AddUser_reply
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	8,  // 0: proto.ListSessions_reply.sessions:type_name -> proto.Session_info
+	39, // 1: proto.BeginPasskey_reply.options:type_name -> google.protobuf.Struct
+	39, // 2: proto.FinishPasskeyRegistration_msg.credential:type_name -> google.protobuf.Struct
+	39, // 3: proto.FinishPasskeyLogin_msg.credential:type_name -> google.protobuf.Struct
+	0,  // 4: proto.Auth.GetTokens:input_type -> proto.GetTokens_msg
+	1,  // 5: proto.Auth.RefreshTokens:input_type -> proto.RefreshTokens_msg
+	40, // 6: proto.Auth.GetGUID:input_type -> google.protobuf.Empty
+	40, // 7: proto.Auth.Logout:input_type -> google.protobuf.Empty
+	40, // 8: proto.Auth.AddUser:input_type -> google.protobuf.Empty
+	40, // 9: proto.Auth.GetJWKS:input_type -> google.protobuf.Empty
+	40, // 10: proto.Auth.GetOpenIDConfiguration:input_type -> google.protobuf.Empty
+	6,  // 11: proto.Auth.IntrospectToken:input_type -> proto.IntrospectToken_msg
+	7,  // 12: proto.Auth.RevokeToken:input_type -> proto.RevokeToken_msg
+	40, // 13: proto.Auth.ListSessions:input_type -> google.protobuf.Empty
+	10, // 14: proto.Auth.RevokeSession:input_type -> proto.RevokeSession_msg
+	11, // 15: proto.Auth.RevokeAllSessions:input_type -> proto.RevokeAllSessions_msg
+	13, // 16: proto.Auth.Register:input_type -> proto.Register_msg
+	15, // 17: proto.Auth.Login:input_type -> proto.Login_msg
+	16, // 18: proto.Auth.RequestPasswordReset:input_type -> proto.RequestPasswordReset_msg
+	17, // 19: proto.Auth.ConfirmPasswordReset:input_type -> proto.ConfirmPasswordReset_msg
+	18, // 20: proto.Auth.SendVerificationEmail:input_type -> proto.SendVerificationEmail_msg
+	19, // 21: proto.Auth.VerifyEmail:input_type -> proto.VerifyEmail_msg
+	40, // 22: proto.Auth.EnrollTOTP:input_type -> google.protobuf.Empty
+	21, // 23: proto.Auth.ConfirmTOTP:input_type -> proto.ConfirmTOTP_msg
+	23, // 24: proto.Auth.VerifyMFA:input_type -> proto.VerifyMFA_msg
+	40, // 25: proto.Auth.BeginPasskeyRegistration:input_type -> google.protobuf.Empty
+	25, // 26: proto.Auth.FinishPasskeyRegistration:input_type -> proto.FinishPasskeyRegistration_msg
+	40, // 27: proto.Auth.BeginPasskeyLogin:input_type -> google.protobuf.Empty
+	27, // 28: proto.Auth.FinishPasskeyLogin:input_type -> proto.FinishPasskeyLogin_msg
+	28, // 29: proto.Auth.StartPasswordlessLogin:input_type -> proto.StartPasswordlessLogin_msg
+	30, // 30: proto.Auth.CompletePasswordlessLogin:input_type -> proto.CompletePasswordlessLogin_msg
+	31, // 31: proto.Auth.Authorize:input_type -> proto.Authorize_msg
+	31, // 32: proto.Auth.ApproveAuthorization:input_type -> proto.Authorize_msg
+	33, // 33: proto.Auth.Token:input_type -> proto.Token_msg
+	34, // 34: proto.Auth.CreateClient:input_type -> proto.CreateClient_msg
+	35, // 35: proto.Auth.UpdateClient:input_type -> proto.UpdateClient_msg
+	36, // 36: proto.Auth.RotateClientSecret:input_type -> proto.RotateClientSecret_msg
+	37, // 37: proto.Auth.DisableClient:input_type -> proto.DisableClient_msg
+	2,  // 38: proto.Auth.GetTokens:output_type -> proto.GetTokens_reply
+	3,  // 39: proto.Auth.RefreshTokens:output_type -> proto.RefreshTokens_reply
+	4,  // 40: proto.Auth.GetGUID:output_type -> proto.GetGUID_reply
+	40, // 41: proto.Auth.Logout:output_type -> google.protobuf.Empty
+	5,  // 42: proto.Auth.AddUser:output_type -> proto.AddUser_reply
+	41, // 43: proto.Auth.GetJWKS:output_type -> google.api.HttpBody
+	41, // 44: proto.Auth.GetOpenIDConfiguration:output_type -> google.api.HttpBody
+	41, // 45: proto.Auth.IntrospectToken:output_type -> google.api.HttpBody
+	41, // 46: proto.Auth.RevokeToken:output_type -> google.api.HttpBody
+	9,  // 47: proto.Auth.ListSessions:output_type -> proto.ListSessions_reply
+	40, // 48: proto.Auth.RevokeSession:output_type -> google.protobuf.Empty
+	12, // 49: proto.Auth.RevokeAllSessions:output_type -> proto.RevokeAllSessions_reply
+	14, // 50: proto.Auth.Register:output_type -> proto.Register_reply
+	2,  // 51: proto.Auth.Login:output_type -> proto.GetTokens_reply
+	40, // 52: proto.Auth.RequestPasswordReset:output_type -> google.protobuf.Empty
+	40, // 53: proto.Auth.ConfirmPasswordReset:output_type -> google.protobuf.Empty
+	40, // 54: proto.Auth.SendVerificationEmail:output_type -> google.protobuf.Empty
+	40, // 55: proto.Auth.VerifyEmail:output_type -> google.protobuf.Empty
+	20, // 56: proto.Auth.EnrollTOTP:output_type -> proto.EnrollTOTP_reply
+	22, // 57: proto.Auth.ConfirmTOTP:output_type -> proto.ConfirmTOTP_reply
+	2,  // 58: proto.Auth.VerifyMFA:output_type -> proto.GetTokens_reply
+	24, // 59: proto.Auth.BeginPasskeyRegistration:output_type -> proto.BeginPasskey_reply
+	26, // 60: proto.Auth.FinishPasskeyRegistration:output_type -> proto.FinishPasskeyRegistration_reply
+	24, // 61: proto.Auth.BeginPasskeyLogin:output_type -> proto.BeginPasskey_reply
+	2,  // 62: proto.Auth.FinishPasskeyLogin:output_type -> proto.GetTokens_reply
+	29, // 63: proto.Auth.StartPasswordlessLogin:output_type -> proto.StartPasswordlessLogin_reply
+	2,  // 64: proto.Auth.CompletePasswordlessLogin:output_type -> proto.GetTokens_reply
+	40, // 65: proto.Auth.Authorize:output_type -> google.protobuf.Empty
+	32, // 66: proto.Auth.ApproveAuthorization:output_type -> proto.ApproveAuthorization_reply
+	41, // 67: proto.Auth.Token:output_type -> google.api.HttpBody
+	38, // 68: proto.Auth.CreateClient:output_type -> proto.Client_reply
+	38, // 69: proto.Auth.UpdateClient:output_type -> proto.Client_reply
+	38, // 70: proto.Auth.RotateClientSecret:output_type -> proto.Client_reply
+	40, // 71: proto.Auth.DisableClient:output_type -> google.protobuf.Empty
+	38, // [38:72] is the sub-list for method output_type
+	4,  // [4:38] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_Proto_auth_proto_init() }
@@ -625,7 +5192,7 @@ func file_Proto_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_Proto_auth_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},